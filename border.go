@@ -0,0 +1,125 @@
+package tymbol
+
+// BorderLine is a horizontal border line. Left, Cross and Right are the
+// junctions with the outer left border, inner column borders and outer right
+// border. The line isn't drawn if Line is zero.
+type BorderLine struct {
+	Left, Cross, Right, Line rune
+}
+
+// BorderVertical holds vertical borders of a row of values.
+type BorderVertical struct {
+	Left, Middle, Right rune
+}
+
+// BorderStyle describes every symbol used to draw table borders.
+type BorderStyle struct {
+	HeaderTop    BorderLine
+	HeaderBottom BorderLine
+	Header       BorderVertical
+
+	// Top is drawn above the first row of a table without headers.
+	Top    BorderLine
+	Row    BorderLine
+	Bottom BorderLine
+	Body   BorderVertical
+
+	// FooterTop separates the footer from the last row, it's drawn instead
+	// of Bottom.
+	FooterTop    BorderLine
+	FooterBottom BorderLine
+	Footer       BorderVertical
+}
+
+var (
+	BorderASCII = BorderStyle{
+		HeaderTop:    BorderLine{'#', '#', '#', '='},
+		HeaderBottom: BorderLine{'#', '#', '#', '='},
+		Header:       BorderVertical{'#', '#', '#'},
+		Top:          BorderLine{'+', '+', '+', '-'},
+		Row:          BorderLine{'+', '+', '+', '-'},
+		Bottom:       BorderLine{'+', '+', '+', '-'},
+		Body:         BorderVertical{'|', '|', '|'},
+		FooterTop:    BorderLine{'#', '#', '#', '='},
+		FooterBottom: BorderLine{'#', '#', '#', '='},
+		Footer:       BorderVertical{'#', '#', '#'},
+	}
+
+	BorderLight = BorderStyle{
+		HeaderTop:    BorderLine{'┌', '┬', '┐', '─'},
+		HeaderBottom: BorderLine{'├', '┼', '┤', '─'},
+		Header:       BorderVertical{'│', '│', '│'},
+		Top:          BorderLine{'┌', '┬', '┐', '─'},
+		Row:          BorderLine{'├', '┼', '┤', '─'},
+		Bottom:       BorderLine{'└', '┴', '┘', '─'},
+		Body:         BorderVertical{'│', '│', '│'},
+		FooterTop:    BorderLine{'├', '┼', '┤', '─'},
+		FooterBottom: BorderLine{'└', '┴', '┘', '─'},
+		Footer:       BorderVertical{'│', '│', '│'},
+	}
+
+	BorderHeavy = BorderStyle{
+		HeaderTop:    BorderLine{'┏', '┳', '┓', '━'},
+		HeaderBottom: BorderLine{'┣', '╋', '┫', '━'},
+		Header:       BorderVertical{'┃', '┃', '┃'},
+		Top:          BorderLine{'┏', '┳', '┓', '━'},
+		Row:          BorderLine{'┣', '╋', '┫', '━'},
+		Bottom:       BorderLine{'┗', '┻', '┛', '━'},
+		Body:         BorderVertical{'┃', '┃', '┃'},
+		FooterTop:    BorderLine{'┣', '╋', '┫', '━'},
+		FooterBottom: BorderLine{'┗', '┻', '┛', '━'},
+		Footer:       BorderVertical{'┃', '┃', '┃'},
+	}
+
+	BorderDouble = BorderStyle{
+		HeaderTop:    BorderLine{'╔', '╦', '╗', '═'},
+		HeaderBottom: BorderLine{'╠', '╬', '╣', '═'},
+		Header:       BorderVertical{'║', '║', '║'},
+		Top:          BorderLine{'╔', '╦', '╗', '═'},
+		Row:          BorderLine{'╠', '╬', '╣', '═'},
+		Bottom:       BorderLine{'╚', '╩', '╝', '═'},
+		Body:         BorderVertical{'║', '║', '║'},
+		FooterTop:    BorderLine{'╠', '╬', '╣', '═'},
+		FooterBottom: BorderLine{'╚', '╩', '╝', '═'},
+		Footer:       BorderVertical{'║', '║', '║'},
+	}
+
+	BorderRounded = BorderStyle{
+		HeaderTop:    BorderLine{'╭', '┬', '╮', '─'},
+		HeaderBottom: BorderLine{'├', '┼', '┤', '─'},
+		Header:       BorderVertical{'│', '│', '│'},
+		Top:          BorderLine{'╭', '┬', '╮', '─'},
+		Row:          BorderLine{'├', '┼', '┤', '─'},
+		Bottom:       BorderLine{'╰', '┴', '╯', '─'},
+		Body:         BorderVertical{'│', '│', '│'},
+		FooterTop:    BorderLine{'├', '┼', '┤', '─'},
+		FooterBottom: BorderLine{'╰', '┴', '╯', '─'},
+		Footer:       BorderVertical{'│', '│', '│'},
+	}
+
+	BorderMarkdown = BorderStyle{
+		HeaderBottom: BorderLine{'|', '|', '|', '-'},
+		Header:       BorderVertical{'|', '|', '|'},
+		Body:         BorderVertical{'|', '|', '|'},
+		Footer:       BorderVertical{'|', '|', '|'},
+	}
+)
+
+// legacyBorderStyle builds a border style from the symbols set with
+// SetCrossHeaderSym, SetHLineSym and others.
+func (o *Options) legacyBorderStyle() BorderStyle {
+	header := BorderLine{o.crossHeaderSym, o.crossHeaderSym, o.crossHeaderSym, o.hHeaderSym}
+	line := BorderLine{o.crossLineSym, o.crossLineSym, o.crossLineSym, o.hLineSym}
+	return BorderStyle{
+		HeaderTop:    header,
+		HeaderBottom: header,
+		Header:       BorderVertical{o.vHeaderSym, o.vHeaderSym, o.vHeaderSym},
+		Top:          line,
+		Row:          line,
+		Bottom:       line,
+		Body:         BorderVertical{o.vLineSym, o.vLineSym, o.vLineSym},
+		FooterTop:    header,
+		FooterBottom: header,
+		Footer:       BorderVertical{o.vHeaderSym, o.vHeaderSym, o.vHeaderSym},
+	}
+}
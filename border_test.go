@@ -0,0 +1,133 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBorderStyle(t *testing.T) {
+	t.Run("Default style is ASCII", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, 2}, {"Bob", "Alice"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		assert.Equal(t, BorderASCII, tab.Options.BorderStyle())
+	})
+
+	t.Run("Light", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, 2}, {"Bob", "Alice"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetBorderStyle(BorderLight)
+
+		want := `┌──────┬─────────┐
+│  id  │  name   │
+├──────┼─────────┤
+│  1   │   Bob   │
+├──────┼─────────┤
+│  2   │  Alice  │
+└──────┴─────────┘
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Double without headers", func(t *testing.T) {
+		tab, _ := NewTable("", nil, [][]interface{}{{1, 2}, {"Bob", "Alice"}})
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetBorderStyle(BorderDouble)
+
+		want := `╔═════╦═════════╗
+║  1  ║   Bob   ║
+╠═════╬═════════╣
+║  2  ║  Alice  ║
+╚═════╩═════════╝
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, 2}, {"Bob", "Alice"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetBorderStyle(BorderMarkdown)
+
+		want := `|  id  |  name   |
+|------|---------|
+|  1   |   Bob   |
+|  2   |  Alice  |
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Symbol setters change preset", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, 2}, {"Bob", "Alice"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetBorderStyle(BorderRounded)
+		tab.Options.SetVLineSym('┆')
+		tab.Options.SetHLineSym('┄')
+
+		want := `╭──────┬─────────╮
+│  id  │  name   │
+├──────┼─────────┤
+┆  1   ┆   Bob   ┆
+├┄┄┄┄┄┄┼┄┄┄┄┄┄┄┄┄┤
+┆  2   ┆  Alice  ┆
+╰┄┄┄┄┄┄┴┄┄┄┄┄┄┄┄┄╯
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+}
+
+func TestToggleBorders(t *testing.T) {
+	t.Run("Without outer border", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, 2}, {"Bob", "Alice"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetBorderStyle(BorderLight)
+		tab.Options.SetOuterBorder(false)
+		assert.False(t, tab.Options.OuterBorder())
+
+		want := "  id  │  name   \n" +
+			"──────┼─────────\n" +
+			"  1   │   Bob   \n" +
+			"──────┼─────────\n" +
+			"  2   │  Alice  \n"
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Without inner border", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, 2}, {"Bob", "Alice"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetInnerBorder(false)
+		assert.False(t, tab.Options.InnerBorder())
+
+		want := `#===============#
+#  id    name   #
+#===============#
+|  1      Bob   |
+|  2     Alice  |
++---------------+
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+}
@@ -0,0 +1,217 @@
+package tymbol
+
+import "fmt"
+
+// ColumnOptions overrides table-wide Options for a single column. Values that
+// weren't set are inherited from Options.
+type ColumnOptions struct {
+	cellLength    int
+	minCellLength int
+	cellPadding   int
+	hasPadding    bool
+	cellAlign     align
+	wrapMode      string
+	overflow      string
+	htmlClass     string
+
+	fitContent bool
+}
+
+func (c *ColumnOptions) CellLength() int {
+	return c.cellLength
+}
+
+// SetCellLength sets fixed length of the column, it's used even if the table
+// fits content.
+func (c *ColumnOptions) SetCellLength(l int) error {
+	if l <= 0 {
+		return fmt.Errorf("Value must be greater than 0")
+	}
+	c.cellLength = l
+	return nil
+}
+
+func (c *ColumnOptions) MinCellLength() int {
+	return c.minCellLength
+}
+
+func (c *ColumnOptions) SetMinCellLength(l int) error {
+	if l <= 0 {
+		return fmt.Errorf("Value must be greater than 0")
+	}
+	c.minCellLength = l
+	return nil
+}
+
+func (c *ColumnOptions) CellPadding() int {
+	return c.cellPadding
+}
+
+func (c *ColumnOptions) SetCellPadding(p int) error {
+	if p < 0 {
+		return fmt.Errorf("Value must be positive")
+	}
+	c.cellPadding = p
+	c.hasPadding = true
+	return nil
+}
+
+func (c *ColumnOptions) CellAlign() align {
+	return c.cellAlign
+}
+
+func (c *ColumnOptions) SetCellAlign(a align) error {
+	if ok := checkAlignOption(a); !ok {
+		return fmt.Errorf("Unknown align option. Expected %v, got %s", availableAligns, a)
+	}
+	c.cellAlign = a
+	return nil
+}
+
+func (c *ColumnOptions) WrapMode() string {
+	return c.wrapMode
+}
+
+func (c *ColumnOptions) SetWrapMode(m string) error {
+	if ok := checkWrapModeOption(m); !ok {
+		return fmt.Errorf("Unknown wrap mode. Expected %v, got %s", availableWrapModes, m)
+	}
+	c.wrapMode = m
+	return nil
+}
+
+func (c *ColumnOptions) Overflow() string {
+	return c.overflow
+}
+
+func (c *ColumnOptions) SetOverflow(m string) error {
+	if ok := checkOverflowOption(m); !ok {
+		return fmt.Errorf("Unknown overflow mode. Expected %v, got %s", availableOverflows, m)
+	}
+	c.overflow = m
+	return nil
+}
+
+func (c *ColumnOptions) HTMLClass() string {
+	return c.htmlClass
+}
+
+// SetHTMLClass sets class attribute of the column's cells in DrawHTML output.
+func (c *ColumnOptions) SetHTMLClass(class string) error {
+	c.htmlClass = class
+	return nil
+}
+
+// FitContent reports whether the column takes the length of its longest
+// value. It's only meaningful for columns returned by Table.ColumnOptions.
+func (c *ColumnOptions) FitContent() bool {
+	return c.fitContent
+}
+
+// merge overrides values of c with the ones set in o.
+func (c ColumnOptions) merge(o ColumnOptions) ColumnOptions {
+	if o.cellLength > 0 {
+		c.cellLength = o.cellLength
+	}
+	if o.minCellLength > 0 {
+		c.minCellLength = o.minCellLength
+	}
+	if o.hasPadding {
+		c.cellPadding, c.hasPadding = o.cellPadding, true
+	}
+	if o.cellAlign != "" {
+		c.cellAlign = o.cellAlign
+	}
+	if o.wrapMode != "" {
+		c.wrapMode = o.wrapMode
+	}
+	if o.overflow != "" {
+		c.overflow = o.overflow
+	}
+	if o.htmlClass != "" {
+		c.htmlClass = o.htmlClass
+	}
+	return c
+}
+
+// ColumnOptions returns options of the column with index col, with every
+// value inherited from Options filled in.
+func (t *Table) ColumnOptions(col int) ColumnOptions {
+	var c ColumnOptions
+	if col < len(t.headers) {
+		c = c.merge(t.Options.headerColumnOptions[t.headers[col]])
+	}
+	c = c.merge(t.Options.columnOptions[col])
+
+	if c.cellLength == 0 {
+		c.cellLength = t.Options.CellLength()
+		if t.Options.CellFitContent() {
+			c.cellLength = t.maxColLength[col]
+			c.fitContent = true
+		}
+	}
+	if c.minCellLength == 0 {
+		c.minCellLength = t.Options.MinCellLength()
+	}
+	if c.minCellLength == 0 {
+		c.minCellLength = 1
+	}
+	if c.minCellLength > c.cellLength {
+		c.minCellLength = c.cellLength
+	}
+	if !c.hasPadding {
+		c.cellPadding, c.hasPadding = t.Options.CellPadding(), true
+	}
+	if c.cellAlign == "" {
+		c.cellAlign = t.Options.CellAlign()
+	}
+	if c.wrapMode == "" {
+		c.wrapMode = t.Options.WrapMode()
+	}
+	if c.overflow == "" {
+		c.overflow = t.Options.Overflow()
+	}
+	return c
+}
+
+// fitColumns returns content length of every column, shrinking the widest
+// ones while the table is wider than MaxWidth.
+func (t *Table) fitColumns(columns []ColumnOptions) []int {
+	lengths := make([]int, len(columns))
+	minLengths := make([]int, len(columns))
+	total := t.bordersLength(len(columns))
+	for i, c := range columns {
+		lengths[i] = c.CellLength()
+		minLengths[i] = c.MinCellLength()
+		total += lengths[i] + 2*c.CellPadding()
+	}
+
+	maxWidth := t.Options.MaxWidth()
+	for maxWidth > 0 && total > maxWidth {
+		widest := -1
+		for i := range lengths {
+			if lengths[i] > minLengths[i] && (widest < 0 || lengths[i] > lengths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		lengths[widest]--
+		total--
+	}
+	return lengths
+}
+
+// bordersLength returns the number of columns taken by borders of a table
+// with n columns.
+func (t *Table) bordersLength(n int) int {
+	var length int
+	if t.Options.OuterBorder() {
+		length += 2
+	}
+	if t.Options.InnerBorder() {
+		length += n - 1
+	}
+	return length
+}
@@ -0,0 +1,193 @@
+package tymbol
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnOptions(t *testing.T) {
+	t.Run("Inherited options", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "value"},
+			[][]interface{}{{1, 2}, {"test", "testtest"}},
+		)
+		c := tab.ColumnOptions(1)
+		assert.Equal(t, 10, c.CellLength())
+		assert.Equal(t, 2, c.CellPadding())
+		assert.Equal(t, CENTER, c.CellAlign())
+		assert.Equal(t, WRAP_CHAR, c.WrapMode())
+		assert.Equal(t, OVERFLOW_WRAP, c.Overflow())
+		assert.False(t, c.FitContent())
+
+		tab.Options.SetCellFitContent(true)
+		c = tab.ColumnOptions(1)
+		assert.Equal(t, 8, c.CellLength())
+		assert.True(t, c.FitContent())
+	})
+
+	t.Run("Per column overrides", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "price", "description"},
+			[][]interface{}{{1, 2}, {3.5, 120}, {"From fairest creatures", "Look in thy glass"}},
+		)
+		tab.Options.SetCellFitContent(true)
+
+		var id ColumnOptions
+		id.SetCellPadding(1)
+		err := tab.Options.SetColumnOptions(0, id)
+		assert.NoError(t, err)
+
+		var price ColumnOptions
+		price.SetCellAlign(RIGHT)
+		err = tab.Options.SetColumnOptionsByHeader("price", price)
+		assert.NoError(t, err)
+
+		var description ColumnOptions
+		description.SetCellLength(12)
+		description.SetCellAlign(LEFT)
+		description.SetWrapMode(WRAP_WORD)
+		err = tab.Options.SetColumnOptionsByHeader("description", description)
+		assert.NoError(t, err)
+
+		got := tab.Draw()
+		want := `#====#=========#================#
+# id #  price  #  description   #
+#====#=========#================#
+| 1  |    3.5  |  From fairest  |
+|    |         |  creatures     |
++----+---------+----------------+
+| 2  |    120  |  Look in thy   |
+|    |         |  glass         |
++----+---------+----------------+
+`
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Index options take precedence", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id"},
+			[][]interface{}{{1}},
+		)
+		var byHeader, byIndex ColumnOptions
+		byHeader.SetCellLength(4)
+		byHeader.SetCellAlign(LEFT)
+		byIndex.SetCellAlign(RIGHT)
+		tab.Options.SetColumnOptionsByHeader("id", byHeader)
+		tab.Options.SetColumnOptions(0, byIndex)
+
+		c := tab.ColumnOptions(0)
+		assert.Equal(t, 4, c.CellLength())
+		assert.Equal(t, RIGHT, c.CellAlign())
+	})
+
+	t.Run("Invalid options", func(t *testing.T) {
+		var c ColumnOptions
+		assert.Error(t, c.SetCellLength(0))
+		assert.Error(t, c.SetCellPadding(-1))
+		assert.Error(t, c.SetCellAlign("top"))
+		assert.Error(t, c.SetWrapMode("hyphen"))
+		assert.Error(t, c.SetOverflow("scroll"))
+
+		var o Options
+		assert.Error(t, o.SetColumnOptions(-1, c))
+	})
+}
+
+func TestMaxWidth(t *testing.T) {
+	data := [][]interface{}{{1, 2}, {"first", "second"}, {"From fairest creatures we desire increase", "When forty winters shall besiege thy brow"}}
+
+	t.Run("Shrink widest column", func(t *testing.T) {
+		tab, _ := NewTable("", []string{"id", "name", "sonnet"}, data)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetWrapMode(WRAP_WORD)
+		err := tab.Options.SetMaxWidth(40)
+		assert.NoError(t, err)
+
+		got := tab.Draw()
+		want := `#======#==========#====================#
+#  id  #   name   #       sonnet       #
+#======#==========#====================#
+|      |          |    From fairest    |
+|  1   |  first   |    creatures we    |
+|      |          |  desire increase   |
++------+----------+--------------------+
+|      |          |     When forty     |
+|  2   |  second  |   winters shall    |
+|      |          |  besiege thy brow  |
++------+----------+--------------------+
+`
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Shrink down to min length", func(t *testing.T) {
+		tab, _ := NewTable("", []string{"id", "name", "sonnet"}, data)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetOverflow(OVERFLOW_TRUNCATE)
+		tab.Options.SetMaxWidth(20)
+		tab.Options.SetMinCellLength(4)
+		var name ColumnOptions
+		name.SetMinCellLength(6)
+		tab.Options.SetColumnOptions(1, name)
+
+		got := tab.Draw()
+		want := `#======#==========#========#
+#  id  #   name   #  son…  #
+#======#==========#========#
+|  1   |  first   |  Fro…  |
++------+----------+--------+
+|  2   |  second  |  Whe…  |
++------+----------+--------+
+`
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Without outer border", func(t *testing.T) {
+		tab, _ := NewTable("", []string{"id", "name"}, [][]interface{}{{1, 2}, {"first", "second"}})
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetOverflow(OVERFLOW_TRUNCATE)
+		tab.Options.SetOuterBorder(false)
+		tab.Options.SetMaxWidth(15)
+
+		got := tab.Draw()
+		want := `  id  #  name  
+======#========
+  1   |  fir…  
+------+--------
+  2   |  sec…  
+`
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Invalid values", func(t *testing.T) {
+		var o Options
+		assert.Error(t, o.SetMaxWidth(-1))
+		assert.Error(t, o.SetMinCellLength(0))
+	})
+}
+
+func TestMaxWidthFromTerminal(t *testing.T) {
+	var o Options
+	var buf bytes.Buffer
+
+	t.Setenv("COLUMNS", "")
+	err := o.SetMaxWidthFromTerminal(&buf)
+	if assert.Error(t, err) {
+		assert.Equal(t, "Unable to detect terminal width", err.Error())
+	}
+
+	t.Setenv("COLUMNS", "wide")
+	err = o.SetMaxWidthFromTerminal(&buf)
+	if assert.Error(t, err) {
+		assert.Equal(t, "Invalid COLUMNS value: wide", err.Error())
+	}
+
+	t.Setenv("COLUMNS", "80")
+	err = o.SetMaxWidthFromTerminal(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 80, o.MaxWidth())
+}
@@ -0,0 +1,86 @@
+package tymbol
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+func NewTableFromCSV(title string, r io.Reader, hasHeader bool) (Table, error) {
+	return NewTableFromDelimited(title, r, ',', hasHeader)
+}
+
+func NewTableFromTSV(title string, r io.Reader, hasHeader bool) (Table, error) {
+	return NewTableFromDelimited(title, r, '\t', hasHeader)
+}
+
+// NewTableFromDelimited reads records separated by comma with encoding/csv
+// rules. If hasHeader is true, the first record is used as headers.
+func NewTableFromDelimited(title string, r io.Reader, comma rune, hasHeader bool) (Table, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	cr.FieldsPerRecord = -1
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return Table{}, err
+	}
+	if len(records) == 0 {
+		return Table{}, fmt.Errorf("Records cannot be empty!")
+	}
+	for i := range records {
+		if len(records[i]) != len(records[0]) {
+			return Table{}, fmt.Errorf("Records must be same length. Assumed len: %d. Diff len record index: %d", len(records[0]), i)
+		}
+	}
+
+	var headers []string
+	if hasHeader {
+		headers, records = records[0], records[1:]
+	}
+	rows := make([][]interface{}, len(records))
+	for i := range records {
+		rows[i] = make([]interface{}, len(records[i]))
+		for j := range records[i] {
+			rows[i][j] = records[i][j]
+		}
+	}
+	return NewTableFromRows(title, headers, rows)
+}
+
+func (t *Table) WriteCSV(w io.Writer) error {
+	return t.WriteDelimited(w, ',')
+}
+
+func (t *Table) WriteTSV(w io.Writer) error {
+	return t.WriteDelimited(w, '\t')
+}
+
+// WriteDelimited writes headers and values of the table as records separated
+// by comma, quoted by encoding/csv rules. Escape sequences are removed.
+func (t *Table) WriteDelimited(w io.Writer, comma rune) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+
+	if len(t.headers) > 0 {
+		record := make([]string, len(t.headers))
+		for i := range t.headers {
+			record[i] = stripEscapes(t.headers[i])
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, len(t.columns))
+	for i := 0; i < t.NumRows(); i++ {
+		for j := range t.columns {
+			record[j] = stripEscapes(t.columns[j][i])
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
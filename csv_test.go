@@ -0,0 +1,112 @@
+package tymbol
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriteCSV(t *testing.T) {
+	tab, _ := NewTable(
+		"",
+		[]string{"id", "name", "note"},
+		[][]interface{}{{1, 2}, {"Bob, Jr.", FgRed.Apply("Alice")}, {"says \"hi\"", "first\nsecond"}},
+	)
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tab.WriteCSV(&buf)
+		assert.NoError(t, err)
+
+		want := "id,name,note\n" +
+			"1,\"Bob, Jr.\",\"says \"\"hi\"\"\"\n" +
+			"2,Alice,\"first\nsecond\"\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("TSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tab.WriteTSV(&buf)
+		assert.NoError(t, err)
+
+		want := "id\tname\tnote\n" +
+			"1\tBob, Jr.\t\"says \"\"hi\"\"\"\n" +
+			"2\tAlice\t\"first\nsecond\"\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("Custom delimiter", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tab.WriteDelimited(&buf, ';')
+		assert.NoError(t, err)
+
+		want := "id;name;note\n" +
+			"1;Bob, Jr.;\"says \"\"hi\"\"\"\n" +
+			"2;Alice;\"first\nsecond\"\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("Write error", func(t *testing.T) {
+		err := tab.WriteCSV(failingWriter{})
+		if assert.Error(t, err) {
+			assert.Equal(t, "write failed", err.Error())
+		}
+	})
+}
+
+func TestNewTableFromCSV(t *testing.T) {
+	t.Run("With headers", func(t *testing.T) {
+		r := strings.NewReader("id,name\n1,\"Bob, Jr.\"\n2,\"first\nsecond\"\n")
+		tab, err := NewTableFromCSV("test", r, true)
+		assert.NoError(t, err)
+
+		want, _ := NewTable(
+			"test",
+			[]string{"id", "name"},
+			[][]interface{}{{"1", "2"}, {"Bob, Jr.", "first\nsecond"}},
+		)
+		assert.Equal(t, want, tab)
+	})
+
+	t.Run("TSV without headers", func(t *testing.T) {
+		r := strings.NewReader("1\tBob\n2\tAlice\n")
+		tab, err := NewTableFromTSV("", r, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []string(nil), tab.headers)
+		assert.Equal(t, [][]string{{"1", "2"}, {"Bob", "Alice"}}, tab.columns)
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		tab, _ := NewTable("", []string{"a", "b"}, [][]interface{}{{"x;y"}, {"\"z\""}})
+		var buf bytes.Buffer
+		tab.WriteDelimited(&buf, ';')
+
+		got, err := NewTableFromDelimited("", &buf, ';', true)
+		assert.NoError(t, err)
+		assert.Equal(t, tab, got)
+	})
+
+	t.Run("Records are different size", func(t *testing.T) {
+		r := strings.NewReader("id,name\n1,Bob\n2\n")
+		_, err := NewTableFromCSV("", r, true)
+		if assert.Error(t, err) {
+			assert.Equal(t, "Records must be same length. Assumed len: 2. Diff len record index: 2", err.Error())
+		}
+	})
+
+	t.Run("Records are empty", func(t *testing.T) {
+		_, err := NewTableFromCSV("", strings.NewReader(""), true)
+		if assert.Error(t, err) {
+			assert.Equal(t, "Records cannot be empty!", err.Error())
+		}
+	})
+}
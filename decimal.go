@@ -0,0 +1,75 @@
+package tymbol
+
+import "strings"
+
+// splitDecimal splits v into integer part and fractional part with the
+// decimal point.
+func splitDecimal(v string) (string, string) {
+	if i := strings.IndexByte(v, '.'); i >= 0 {
+		return v[:i], v[i:]
+	}
+	return v, ""
+}
+
+// isDecimalNumber reports whether v looks like a formatted number: an
+// optional sign, digits possibly grouped with commas, spaces, underscores or
+// apostrophes, an optional fractional part, exponent and percent sign.
+func isDecimalNumber(v string) bool {
+	v = strings.TrimSuffix(stripEscapes(v), "%")
+	v = strings.TrimLeft(v, "+-")
+	if e := strings.IndexAny(v, "eE"); e >= 0 {
+		exp := strings.TrimLeft(v[e+1:], "+-")
+		if exp == "" || strings.Trim(exp, "0123456789") != "" {
+			return false
+		}
+		v = v[:e]
+	}
+	intPart, fracPart := splitDecimal(v)
+	if fracPart != "" {
+		fracPart = fracPart[1:]
+		if fracPart == "" || strings.Trim(fracPart, "0123456789") != "" {
+			return false
+		}
+	}
+	if intPart == "" {
+		return fracPart != ""
+	}
+	return intPart[0] >= '0' && intPart[0] <= '9' && strings.Trim(intPart, "0123456789,_' ") == ""
+}
+
+// decimalWidths returns width of the widest integer part and the widest
+// fractional part of numbers in the column. Multi-line values, values which
+// aren't numbers and, if limit is positive, values wider than limit are
+// skipped.
+func (t *Table) decimalWidths(col, limit int) (int, int) {
+	var intWidth, fracWidth int
+	for _, v := range t.columns[col] {
+		if strings.Contains(v, NEW_LINE) || !isDecimalNumber(v) || (limit > 0 && stringWidth(v) > limit) {
+			continue
+		}
+		intPart, fracPart := splitDecimal(v)
+		if w := stringWidth(intPart); w > intWidth {
+			intWidth = w
+		}
+		if w := stringWidth(fracPart); w > fracWidth {
+			fracWidth = w
+		}
+	}
+	return intWidth, fracWidth
+}
+
+// alignDecimal pads a single line number so its decimal point is in the same
+// position as in other numbers of the column. Values are drawn right-aligned,
+// so only the fractional part needs padding. Lines which aren't numbers or
+// don't fit with the padding are left as they are.
+func (r *renderer) alignDecimal(col int, lines []string) []string {
+	if len(lines) != 1 || !isDecimalNumber(lines[0]) {
+		return lines
+	}
+	_, fracPart := splitDecimal(lines[0])
+	padding := r.l.fracWidths[col] - stringWidth(fracPart)
+	if padding <= 0 || stringWidth(lines[0])+padding > r.l.cellLengths[col] {
+		return lines
+	}
+	return []string{lines[0] + strings.Repeat(SPACE, padding)}
+}
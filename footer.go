@@ -0,0 +1,146 @@
+package tymbol
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+const (
+	AGGREGATE_SUM            = "sum"
+	AGGREGATE_AVG            = "avg"
+	AGGREGATE_MIN            = "min"
+	AGGREGATE_MAX            = "max"
+	AGGREGATE_COUNT          = "count"
+	AGGREGATE_COUNT_DISTINCT = "count-distinct"
+)
+
+var availableAggregates = [6]string{AGGREGATE_SUM, AGGREGATE_AVG, AGGREGATE_MIN, AGGREGATE_MAX, AGGREGATE_COUNT, AGGREGATE_COUNT_DISTINCT}
+
+func checkAggregateOption(a string) bool {
+	for i := 0; i < len(availableAggregates); i++ {
+		if a == availableAggregates[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// SetFooter sets values of the footer row drawn below the body. Footer
+// values aren't sorted or filtered with rows.
+func (t *Table) SetFooter(values ...interface{}) error {
+	if len(values) != len(t.columns) {
+		return fmt.Errorf("Number of values and columns don't match: %d %d", len(values), len(t.columns))
+	}
+	t.footer = values
+	return nil
+}
+
+// SetFooterAggregate computes the footer value of the column with index col
+// from its values, it takes precedence over the value set with SetFooter.
+// Empty aggregate removes the previous one.
+func (t *Table) SetFooterAggregate(col int, a string) error {
+	if col < 0 || col >= len(t.columns) {
+		return fmt.Errorf("Column index out of range: %d", col)
+	}
+	if a == "" {
+		delete(t.footerAggregates, col)
+		return nil
+	}
+	if ok := checkAggregateOption(a); !ok {
+		return fmt.Errorf("Unknown aggregate. Expected %v, got %s", availableAggregates, a)
+	}
+	if t.footerAggregates == nil {
+		t.footerAggregates = make(map[int]string)
+	}
+	t.footerAggregates[col] = a
+	return nil
+}
+
+// footerRow returns formatted values of the footer or nil if the table has
+// no footer.
+func (t *Table) footerRow() []string {
+	if t.footer == nil && len(t.footerAggregates) == 0 {
+		return nil
+	}
+
+	row := make([]string, len(t.columns))
+	for i := range row {
+		if a, ok := t.footerAggregates[i]; ok {
+			if v, ok := aggregate(a, t.values[i]); ok {
+				row[i] = t.formatValue(i, v)
+			}
+		} else if t.footer != nil && t.footer[i] != nil {
+			row[i] = t.formatValue(i, t.footer[i])
+		}
+	}
+	return row
+}
+
+// aggregate computes a over values. Sum, avg, min and max use only numeric
+// values, it returns false if there are none.
+func aggregate(a string, values []interface{}) (interface{}, bool) {
+	switch a {
+	case AGGREGATE_COUNT:
+		var n int
+		for _, v := range values {
+			if v != nil {
+				n++
+			}
+		}
+		return n, true
+	case AGGREGATE_COUNT_DISTINCT:
+		seen := make(map[string]struct{})
+		for _, v := range values {
+			if v != nil {
+				seen[fmt.Sprintf("%v", v)] = struct{}{}
+			}
+		}
+		return len(seen), true
+	}
+
+	var result interface{}
+	var sum, extreme float64
+	// Integers are summed separately, float64 loses digits above 2^53.
+	intSum := new(big.Int)
+	var n int
+	integers := true
+	for _, v := range values {
+		f, ok := toFloat(v)
+		if !ok {
+			continue
+		}
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			integers = false
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			intSum.Add(intSum, new(big.Int).SetUint64(rv.Uint()))
+		default:
+			intSum.Add(intSum, big.NewInt(rv.Int()))
+		}
+		if n == 0 || (a == AGGREGATE_MIN && f < extreme) || (a == AGGREGATE_MAX && f > extreme) {
+			result, extreme = v, f
+		}
+		sum += f
+		n++
+	}
+	if n == 0 {
+		return nil, false
+	}
+
+	switch a {
+	case AGGREGATE_SUM:
+		// Sums which don't fit 64 bits fall back to float64.
+		switch {
+		case integers && intSum.IsInt64():
+			return intSum.Int64(), true
+		case integers && intSum.IsUint64():
+			return intSum.Uint64(), true
+		}
+		return sum, true
+	case AGGREGATE_AVG:
+		return sum / float64(n), true
+	}
+	return result, true
+}
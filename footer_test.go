@@ -0,0 +1,143 @@
+package tymbol
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFooter(t *testing.T) {
+	t.Run("Explicit values and aggregates", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"item", "qty", "price"},
+			[][]interface{}{{"apple", 3, 1.5}, {"pear", 12, 0.25}, {"apple", 1, 2.0}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		err := tab.SetFooter("total", nil, nil)
+		assert.NoError(t, err)
+		err = tab.SetFooterAggregate(1, AGGREGATE_SUM)
+		assert.NoError(t, err)
+		err = tab.SetFooterAggregate(2, AGGREGATE_AVG)
+		assert.NoError(t, err)
+		tab.SetColumnFormatter(2, NewDecimalFormatter(2))
+
+		want := `#=======#=====#=======#
+# item  # qty # price #
+#=======#=====#=======#
+| apple |  3  | 1.50  |
++-------+-----+-------+
+| pear  | 12  | 0.25  |
++-------+-----+-------+
+| apple |  1  | 2.00  |
+#=======#=====#=======#
+# total # 16  # 1.25  #
+#=======#=====#=======#
+`
+		assert.Equal(t, want, tab.Draw())
+
+		tab.SortBy(1, true)
+		got := tab.Draw()
+		assert.Contains(t, got, "# total # 16  # 1.25  #\n")
+	})
+
+	t.Run("Border style", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"item", "qty", "price"},
+			[][]interface{}{{"apple", 3, 1.5}, {"pear", 12, 0.25}, {"apple", 1, 2.0}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetBorderStyle(BorderLight)
+		tab.Options.SetInnerBorder(false)
+		tab.SetFooterAggregate(0, AGGREGATE_COUNT_DISTINCT)
+		tab.SetFooterAggregate(2, AGGREGATE_MAX)
+
+		want := `┌───────────────────┐
+│ item   qty  price │
+├───────────────────┤
+│ apple   3    1.5  │
+│ pear   12   0.25  │
+│ apple   1     2   │
+├───────────────────┤
+│   2           2   │
+└───────────────────┘
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Footer widens column", func(t *testing.T) {
+		tab, _ := NewTable("", []string{"n"}, [][]interface{}{{900000, 900000}})
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(0)
+		tab.SetFooterAggregate(0, AGGREGATE_SUM)
+
+		want := `#=======#
+#   n   #
+#=======#
+|900000 |
++-------+
+|900000 |
+#=======#
+#1800000#
+#=======#
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"item", "qty", "price"},
+			[][]interface{}{{"apple", 3, 1.5}, {"pear", 12, 0.25}, {"apple", 1, 2.0}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		assert.Error(t, tab.SetFooter(1, 2))
+		assert.Error(t, tab.SetFooterAggregate(3, AGGREGATE_SUM))
+		assert.Error(t, tab.SetFooterAggregate(0, "median"))
+	})
+}
+
+func TestAggregate(t *testing.T) {
+	values := []interface{}{3, "n/a", int64(7), nil, 3}
+	tests := []struct {
+		a    string
+		want interface{}
+	}{
+		{AGGREGATE_SUM, int64(13)},
+		{AGGREGATE_AVG, 13.0 / 3},
+		{AGGREGATE_MIN, 3},
+		{AGGREGATE_MAX, int64(7)},
+		{AGGREGATE_COUNT, 4},
+		{AGGREGATE_COUNT_DISTINCT, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a, func(t *testing.T) {
+			got, ok := aggregate(tt.a, values)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got, _ := aggregate(AGGREGATE_SUM, []interface{}{int64(9007199254740993), 1})
+	assert.Equal(t, int64(9007199254740994), got)
+	got, _ = aggregate(AGGREGATE_SUM, []interface{}{uint64(math.MaxUint64 - 1), uint8(1)})
+	assert.Equal(t, uint64(math.MaxUint64), got)
+	got, _ = aggregate(AGGREGATE_SUM, []interface{}{uint(2), -5})
+	assert.Equal(t, int64(-3), got)
+	got, _ = aggregate(AGGREGATE_SUM, []interface{}{int64(math.MaxInt64), int64(1)})
+	assert.Equal(t, uint64(math.MaxInt64+1), got)
+	got, _ = aggregate(AGGREGATE_SUM, []interface{}{uint64(math.MaxUint64), uint64(1)})
+	assert.Equal(t, float64(math.MaxUint64)+1, got)
+	got, _ = aggregate(AGGREGATE_SUM, []interface{}{uint64(math.MaxUint64), -2})
+	assert.Equal(t, uint64(math.MaxUint64-2), got)
+	got, _ = aggregate(AGGREGATE_SUM, []interface{}{int64(math.MinInt64), -1})
+	assert.Equal(t, float64(math.MinInt64)-1, got)
+
+	_, ok := aggregate(AGGREGATE_SUM, []interface{}{"a"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,221 @@
+package tymbol
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formatter converts a value of a cell to the string that is drawn.
+type Formatter interface {
+	Format(v interface{}) string
+}
+
+// FormatterFunc is an adapter to use ordinary functions as formatters.
+type FormatterFunc func(v interface{}) string
+
+func (f FormatterFunc) Format(v interface{}) string {
+	return f(v)
+}
+
+// SetColumnFormatter sets formatter of the column with index col and formats
+// its values again. Nil formatter removes the previous one.
+func (t *Table) SetColumnFormatter(col int, f Formatter) error {
+	if col < 0 || col >= len(t.columns) {
+		return fmt.Errorf("Column index out of range: %d", col)
+	}
+	if f == nil {
+		delete(t.columnFormatters, col)
+	} else {
+		if t.columnFormatters == nil {
+			t.columnFormatters = make(map[int]Formatter)
+		}
+		t.columnFormatters[col] = f
+	}
+	t.reformat()
+	return nil
+}
+
+// SetTypeFormatter sets formatter of every value of type typ, column
+// formatters take precedence. Nil formatter removes the previous one.
+func (t *Table) SetTypeFormatter(typ reflect.Type, f Formatter) error {
+	if typ == nil {
+		return fmt.Errorf("Type cannot be nil")
+	}
+	if f == nil {
+		delete(t.typeFormatters, typ)
+	} else {
+		if t.typeFormatters == nil {
+			t.typeFormatters = make(map[reflect.Type]Formatter)
+		}
+		t.typeFormatters[typ] = f
+	}
+	t.reformat()
+	return nil
+}
+
+func (t *Table) formatValue(col int, v interface{}) string {
+	if f, ok := t.columnFormatters[col]; ok {
+		return f.Format(v)
+	}
+	if f, ok := t.typeFormatters[reflect.TypeOf(v)]; ok {
+		return f.Format(v)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// reformat formats every value again and updates lengths of columns and rows.
+func (t *Table) reformat() {
+	for i := range t.values {
+		for j, v := range t.values[i] {
+			t.columns[i][j] = t.formatValue(i, v)
+		}
+	}
+	t.measureContent()
+}
+
+// NewDecimalFormatter formats numbers with fixed number of decimal places.
+func NewDecimalFormatter(places int) Formatter {
+	return FormatterFunc(func(v interface{}) string {
+		s, neg, ok := formatNumber(v, places)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		if neg {
+			return "-" + s
+		}
+		return s
+	})
+}
+
+// NewThousandsFormatter formats numbers with fixed number of decimal places
+// and groups digits of the integer part by three with sep.
+func NewThousandsFormatter(places int, sep string) Formatter {
+	return FormatterFunc(func(v interface{}) string {
+		s, neg, ok := formatNumber(v, places)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		intPart, fracPart := s, ""
+		if i := strings.IndexByte(s, '.'); i >= 0 {
+			intPart, fracPart = s[:i], s[i:]
+		}
+
+		var b strings.Builder
+		if neg {
+			b.WriteString("-")
+		}
+		for i := range intPart {
+			if i > 0 && (len(intPart)-i)%3 == 0 {
+				b.WriteString(sep)
+			}
+			b.WriteByte(intPart[i])
+		}
+		b.WriteString(fracPart)
+		return b.String()
+	})
+}
+
+// formatNumber returns the absolute value of an integer or float with fixed
+// number of decimal places and whether it's negative. Integers aren't
+// converted to float64, so they keep all digits, and values rounded to zero
+// have no sign.
+func formatNumber(v interface{}, places int) (s string, neg bool, ok bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(rv.Int(), 10)
+		if neg = strings.HasPrefix(s, "-"); neg {
+			s = s[1:]
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s = strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		s = strconv.FormatFloat(math.Abs(f), 'f', places, 64)
+		neg = f < 0 && strings.Trim(s, "0.") != ""
+		return s, neg, true
+	default:
+		return "", false, false
+	}
+	if places > 0 {
+		s += "." + strings.Repeat("0", places)
+	}
+	return s, neg, true
+}
+
+// NewPercentFormatter formats fractions as percentages, e.g. 0.25 as "25%".
+func NewPercentFormatter(places int) Formatter {
+	return FormatterFunc(func(v interface{}) string {
+		f, ok := toFloat(v)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		return strconv.FormatFloat(f*100, 'f', places, 64) + "%"
+	})
+}
+
+var byteUnits = [...]string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// NewBytesFormatter formats numbers of bytes in binary units, e.g. 1536 as
+// "1.5 KiB".
+func NewBytesFormatter() Formatter {
+	return FormatterFunc(func(v interface{}) string {
+		f, ok := toFloat(v)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		unit := 0
+		for math.Abs(f) >= 1024 && unit < len(byteUnits)-1 {
+			f /= 1024
+			unit++
+		}
+		if unit == 0 {
+			return strconv.FormatFloat(f, 'f', -1, 64) + " " + byteUnits[unit]
+		}
+		return strconv.FormatFloat(f, 'f', 1, 64) + " " + byteUnits[unit]
+	})
+}
+
+// NewDurationFormatter formats durations rounded to round. Zero round keeps
+// durations as they are.
+func NewDurationFormatter(round time.Duration) Formatter {
+	return FormatterFunc(func(v interface{}) string {
+		d, ok := v.(time.Duration)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		if round > 0 {
+			d = d.Round(round)
+		}
+		return d.String()
+	})
+}
+
+// NewTimeFormatter formats times with layout, see time.Layout.
+func NewTimeFormatter(layout string) Formatter {
+	return FormatterFunc(func(v interface{}) string {
+		t, ok := v.(time.Time)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		return t.Format(layout)
+	})
+}
+
+// toFloat converts any integer or float value to float64.
+func toFloat(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,83 @@
+package tymbol
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatters(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Formatter
+		v    interface{}
+		want string
+	}{
+		{"Decimal", NewDecimalFormatter(2), 1.0000003, "1.00"},
+		{"Decimal int", NewDecimalFormatter(1), 7, "7.0"},
+		{"Decimal fallback", NewDecimalFormatter(2), "n/a", "n/a"},
+		{"Thousands", NewThousandsFormatter(0, ","), 1234567, "1,234,567"},
+		{"Thousands negative", NewThousandsFormatter(2, " "), -9876.5, "-9 876.50"},
+		{"Thousands short", NewThousandsFormatter(0, ","), 999, "999"},
+		{"Thousands big int", NewThousandsFormatter(0, ","), int64(9007199254740993), "9,007,199,254,740,993"},
+		{"Thousands uint", NewThousandsFormatter(1, ","), uint64(18446744073709551615), "18,446,744,073,709,551,615.0"},
+		{"Thousands negative zero", NewThousandsFormatter(0, ","), -0.2, "0"},
+		{"Decimal negative zero", NewDecimalFormatter(1), -0.04, "0.0"},
+		{"Percent", NewPercentFormatter(1), 0.256, "25.6%"},
+		{"Bytes", NewBytesFormatter(), 512, "512 B"},
+		{"KiB", NewBytesFormatter(), 1536, "1.5 KiB"},
+		{"MiB", NewBytesFormatter(), uint64(5 << 20), "5.0 MiB"},
+		{"Duration", NewDurationFormatter(time.Millisecond), 1500*time.Microsecond + 300, "2ms"},
+		{"Time", NewTimeFormatter(time.DateOnly), time.Date(2023, 5, 17, 10, 0, 0, 0, time.UTC), "2023-05-17"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.f.Format(tt.v))
+		})
+	}
+}
+
+func TestTableFormatters(t *testing.T) {
+	t.Run("Column and type formatters", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"size", "ratio", "took"},
+			[][]interface{}{{1024, 3 << 20}, {0.5, 0.125}, {time.Second, 90 * time.Second}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+
+		err := tab.SetColumnFormatter(0, NewBytesFormatter())
+		assert.NoError(t, err)
+		err = tab.SetTypeFormatter(reflect.TypeOf(0.0), NewPercentFormatter(1))
+		assert.NoError(t, err)
+		want := `#=========#=======#=======#
+#  size   # ratio # took  #
+#=========#=======#=======#
+| 1.0 KiB | 50.0% |  1s   |
++---------+-------+-------+
+| 3.0 MiB | 12.5% | 1m30s |
++---------+-------+-------+
+`
+		assert.Equal(t, want, tab.Draw())
+
+		tab.SetColumnFormatter(0, nil)
+		assert.Equal(t, "1024", tab.columns[0][0])
+	})
+
+	t.Run("Appended rows are formatted", func(t *testing.T) {
+		tab, _ := NewTableFromRows("", []string{"n"}, nil)
+		tab.SetColumnFormatter(0, NewThousandsFormatter(0, ","))
+		tab.AppendRow(1000000)
+		assert.Equal(t, "1,000,000", tab.columns[0][0])
+		assert.Equal(t, []int{9}, tab.maxColLength)
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		tab, _ := NewTable("", nil, [][]interface{}{{1}})
+		assert.Error(t, tab.SetColumnFormatter(1, NewBytesFormatter()))
+		assert.Error(t, tab.SetTypeFormatter(nil, NewBytesFormatter()))
+	})
+}
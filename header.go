@@ -0,0 +1,111 @@
+package tymbol
+
+import "fmt"
+
+// Header is a node of a header tree. Headers without children are headers of
+// columns, others are drawn as bands above their children.
+type Header struct {
+	Name     string
+	Children []Header
+}
+
+// SetHeaderTree replaces headers with leaves of the tree, parents are drawn
+// as bands above them. Spans set with SetHeaderSpan are removed.
+func (t *Table) SetHeaderTree(tree ...Header) error {
+	var leaves []string
+	var collect func(nodes []Header)
+	collect = func(nodes []Header) {
+		for _, n := range nodes {
+			if len(n.Children) == 0 {
+				leaves = append(leaves, n.Name)
+			}
+			collect(n.Children)
+		}
+	}
+	collect(tree)
+	if len(leaves) != len(t.columns) {
+		return fmt.Errorf("Number of headers and columns don't match: %d %d", len(leaves), len(t.columns))
+	}
+
+	t.headers = leaves
+	t.headerTree = tree
+	t.headerSpans = nil
+	t.measureContent()
+	return nil
+}
+
+// headerCell is a cell of a header row, it spans cols columns. Leaves of a
+// header tree span every row below their level.
+type headerCell struct {
+	name      string
+	level     int
+	col, cols int
+	leaf      bool
+}
+
+// headerGrid returns owners of header cells for every header row and the
+// cells by their owners. Header rows have negative row indexes.
+func (t *Table) headerGrid() ([][]cellIndex, map[cellIndex]headerCell) {
+	if len(t.headers) == 0 {
+		return nil, nil
+	}
+	cells := make(map[cellIndex]headerCell)
+	if t.headerTree == nil {
+		owners := make([]cellIndex, len(t.columns))
+		for c := 0; c < len(t.columns); {
+			cols := 1
+			if n, ok := t.headerSpans[c]; ok {
+				cols = n
+			}
+			idx := cellIndex{-1, c}
+			cells[idx] = headerCell{t.headers[c], 0, c, cols, true}
+			for k := c; k < c+cols; k++ {
+				owners[k] = idx
+			}
+			c += cols
+		}
+		return [][]cellIndex{owners}, cells
+	}
+
+	levels := headerDepth(t.headerTree)
+	owners := make([][]cellIndex, levels)
+	for l := range owners {
+		owners[l] = make([]cellIndex, len(t.columns))
+	}
+	col := 0
+	var walk func(nodes []Header, level int)
+	walk = func(nodes []Header, level int) {
+		for _, n := range nodes {
+			start := col
+			last := level
+			if len(n.Children) == 0 {
+				col++
+				last = levels - 1
+			} else {
+				walk(n.Children, level+1)
+			}
+			idx := cellIndex{-1 - level, start}
+			cells[idx] = headerCell{n.Name, level, start, col - start, len(n.Children) == 0}
+			for l := level; l <= last; l++ {
+				for k := start; k < col; k++ {
+					owners[l][k] = idx
+				}
+			}
+		}
+	}
+	walk(t.headerTree, 0)
+	return owners, cells
+}
+
+func headerDepth(nodes []Header) int {
+	if len(nodes) == 0 {
+		return 0
+	}
+	depth := 0
+	for _, n := range nodes {
+		if d := headerDepth(n.Children); d > depth {
+			depth = d
+		}
+	}
+	return depth + 1
+}
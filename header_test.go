@@ -0,0 +1,131 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderTree(t *testing.T) {
+	latency := Header{Name: "Latency, ms", Children: []Header{{Name: "p50"}, {Name: "p95"}, {Name: "p99"}}}
+
+	t.Run("Band above children", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"host", "lat_p50", "lat_p95", "lat_p99", "errors"},
+			[][]interface{}{{"api", 12, 48, 120, 0.1}, {"db", 3, 9, 30, 0.02}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		err := tab.SetHeaderTree(Header{Name: "host"}, latency, Header{Name: "errors"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"host", "p50", "p95", "p99", "errors"}, tab.headers)
+
+		want := `#======#=================#========#
+#      #   Latency, ms   #        #
+#      #=====#=====#=====#        #
+# host # p50 # p95 # p99 # errors #
+#======#=====#=====#=====#========#
+| api  | 12  | 48  | 120 |  0.1   |
++------+-----+-----+-----+--------+
+|  db  |  3  |  9  | 30  |  0.02  |
++------+-----+-----+-----+--------+
+`
+		assert.Equal(t, want, tab.Draw())
+
+		tab.Options.SetBorderStyle(BorderLight)
+		want = `┌──────┬─────────────────┬────────┐
+│      │   Latency, ms   │        │
+│      ├─────┬─────┬─────┤        │
+│ host │ p50 │ p95 │ p99 │ errors │
+├──────┼─────┼─────┼─────┼────────┤
+│ api  │ 12  │ 48  │ 120 │  0.1   │
+├──────┼─────┼─────┼─────┼────────┤
+│  db  │  3  │  9  │ 30  │  0.02  │
+└──────┴─────┴─────┴─────┴────────┘
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Nested groups widen columns", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"host", "lat_p50", "lat_p95", "lat_p99", "errors"},
+			[][]interface{}{{"api", 12, 48, 120, 0.1}, {"db", 3, 9, 30, 0.02}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetBorderStyle(BorderLight)
+		tab.Options.SetHeaderAlign(LEFT)
+		err := tab.SetHeaderTree(
+			Header{Name: "Service", Children: []Header{
+				{Name: "host"},
+				{Name: "Latency of all requests", Children: latency.Children},
+			}},
+			Header{Name: "errors"},
+		)
+		assert.NoError(t, err)
+
+		want := `┌────────────────────────────────┬────────┐
+│ Service                        │        │
+├──────┬─────────────────────────┤        │
+│      │ Latency of all requests │        │
+│      ├────────┬────────┬───────┤        │
+│ host │ p50    │ p95    │ p99   │ errors │
+├──────┼────────┼────────┼───────┼────────┤
+│ api  │   12   │   48   │  120  │  0.1   │
+├──────┼────────┼────────┼───────┼────────┤
+│  db  │   3    │   9    │  30   │  0.02  │
+└──────┴────────┴────────┴───────┴────────┘
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Views", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"host", "lat_p50", "lat_p95", "lat_p99", "errors"},
+			[][]interface{}{{"api", 12, 48, 120, 0.1}, {"db", 3, 9, 30, 0.02}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.SetHeaderTree(Header{Name: "host"}, latency, Header{Name: "errors"})
+
+		head := tab.Head(1)
+		assert.Equal(t, tab.headerTree, head.headerTree)
+
+		sel, err := tab.Select("host", "p50", "p99")
+		assert.NoError(t, err)
+		want := `#======#=============#
+#      # Latency, ms #
+#      #======#======#
+# host # p50  # p99  #
+#======#======#======#
+| api  |  12  | 120  |
++------+------+------+
+|  db  |  3   |  30  |
++------+------+------+
+`
+		assert.Equal(t, want, sel.Draw())
+
+		sel, _ = tab.Select("errors", "host")
+		assert.Nil(t, sel.headerTree)
+	})
+
+	t.Run("Invalid tree", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"host", "lat_p50", "lat_p95", "lat_p99", "errors"},
+			[][]interface{}{{"api", 12, 48, 120, 0.1}, {"db", 3, 9, 30, 0.02}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		err := tab.SetHeaderTree(Header{Name: "host"}, latency)
+		if assert.Error(t, err) {
+			assert.Equal(t, "Number of headers and columns don't match: 4 5", err.Error())
+		}
+
+		tab.SetHeaderTree(Header{Name: "host"}, latency, Header{Name: "errors"})
+		assert.Error(t, tab.SetHeaderSpan(1, 2))
+	})
+}
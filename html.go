@@ -0,0 +1,65 @@
+package tymbol
+
+import (
+	"html"
+	"strings"
+)
+
+// DrawHTML renders the table as <table> markup. Values are HTML-escaped and
+// aligns are expressed with text-align styles. Classes of cells and rows are
+// set with ColumnOptions.SetHTMLClass and Options.SetRowHTMLClass.
+func (t *Table) DrawHTML() string {
+	var b strings.Builder
+	b.WriteString("<table>" + NEW_LINE)
+	if t.Title != "" {
+		b.WriteString("  <caption>" + htmlText(t.Title) + "</caption>" + NEW_LINE)
+	}
+
+	if len(t.headers) > 0 {
+		b.WriteString("  <thead>" + NEW_LINE)
+		b.WriteString("    <tr>" + NEW_LINE)
+		for i := range t.headers {
+			c := t.ColumnOptions(i)
+			writeHTMLCell(&b, "th", c.HTMLClass(), t.Options.HeaderAlign(), t.headers[i])
+		}
+		b.WriteString("    </tr>" + NEW_LINE)
+		b.WriteString("  </thead>" + NEW_LINE)
+	}
+
+	b.WriteString("  <tbody>" + NEW_LINE)
+	for i := 0; i < t.NumRows(); i++ {
+		b.WriteString("    <tr" + htmlClass(t.Options.RowHTMLClass(i)) + ">" + NEW_LINE)
+		for j := range t.columns {
+			c := t.ColumnOptions(j)
+			writeHTMLCell(&b, "td", c.HTMLClass(), c.CellAlign(), t.columns[j][i])
+		}
+		b.WriteString("    </tr>" + NEW_LINE)
+	}
+	b.WriteString("  </tbody>" + NEW_LINE)
+	b.WriteString("</table>" + NEW_LINE)
+	return b.String()
+}
+
+func writeHTMLCell(b *strings.Builder, tag, class string, cellAlign align, v string) {
+	if cellAlign == DECIMAL {
+		cellAlign = RIGHT
+	}
+	b.WriteString("      <" + tag + htmlClass(class) + ` style="text-align: ` + cellAlign + `">`)
+	b.WriteString(htmlText(v))
+	b.WriteString("</" + tag + ">" + NEW_LINE)
+}
+
+func htmlClass(class string) string {
+	if class == "" {
+		return ""
+	}
+	return ` class="` + html.EscapeString(class) + `"`
+}
+
+func htmlText(v string) string {
+	lines := splitParagraphs(stripEscapes(v))
+	for i := range lines {
+		lines[i] = html.EscapeString(lines[i])
+	}
+	return strings.Join(lines, "<br>")
+}
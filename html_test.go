@@ -0,0 +1,58 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawHTML(t *testing.T) {
+	t.Run("HTML table", func(t *testing.T) {
+		tab, _ := NewTable(
+			"Scores <2023>",
+			[]string{"id", "Name"},
+			[][]interface{}{{1, 2}, {"Bob & Co", FgRed.Apply("Alice\nSmith")}},
+		)
+		var id ColumnOptions
+		id.SetCellAlign(RIGHT)
+		id.SetHTMLClass("num")
+		tab.Options.SetColumnOptions(0, id)
+		tab.Options.SetRowHTMLClass(1, "failed")
+
+		want := `<table>
+  <caption>Scores &lt;2023&gt;</caption>
+  <thead>
+    <tr>
+      <th class="num" style="text-align: center">id</th>
+      <th style="text-align: center">Name</th>
+    </tr>
+  </thead>
+  <tbody>
+    <tr>
+      <td class="num" style="text-align: right">1</td>
+      <td style="text-align: center">Bob &amp; Co</td>
+    </tr>
+    <tr class="failed">
+      <td class="num" style="text-align: right">2</td>
+      <td style="text-align: center">Alice<br>Smith</td>
+    </tr>
+  </tbody>
+</table>
+`
+		assert.Equal(t, want, tab.DrawHTML())
+	})
+
+	t.Run("Headerless table", func(t *testing.T) {
+		tab, _ := NewTable("", nil, [][]interface{}{{1}})
+
+		want := `<table>
+  <tbody>
+    <tr>
+      <td style="text-align: center">1</td>
+    </tr>
+  </tbody>
+</table>
+`
+		assert.Equal(t, want, tab.DrawHTML())
+	})
+}
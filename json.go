@@ -0,0 +1,172 @@
+package tymbol
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NewTableFromJSON reads a JSON array of objects or newline-delimited JSON
+// objects. Headers are the union of object keys in the order they were first
+// seen, or sorted if sortKeys is true. Missing keys and nulls are filled with
+// placeholder, nested objects and arrays are rendered as compact JSON.
+func NewTableFromJSON(title string, r io.Reader, sortKeys bool, placeholder string) (Table, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var objects []map[string]interface{}
+	var keys []string
+	seen := make(map[string]bool)
+	readObject := func() error {
+		obj, objKeys, err := decodeJSONObject(dec)
+		if err != nil {
+			return err
+		}
+		for _, k := range objKeys {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+		objects = append(objects, obj)
+		return nil
+	}
+
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return Table{}, fmt.Errorf("Objects cannot be empty!")
+	}
+	if err != nil {
+		return Table{}, err
+	}
+	switch tok {
+	case json.Delim('['):
+		for dec.More() {
+			if err := expectJSONDelim(dec, '{'); err != nil {
+				return Table{}, err
+			}
+			if err := readObject(); err != nil {
+				return Table{}, err
+			}
+		}
+		if err := expectJSONDelim(dec, ']'); err != nil {
+			return Table{}, err
+		}
+	case json.Delim('{'):
+		for {
+			if err := readObject(); err != nil {
+				return Table{}, err
+			}
+			if err := expectJSONDelim(dec, '{'); err == io.EOF {
+				break
+			} else if err != nil {
+				return Table{}, err
+			}
+		}
+	default:
+		return Table{}, fmt.Errorf("Expected JSON array or objects, got %v", tok)
+	}
+
+	if len(keys) == 0 {
+		return Table{}, fmt.Errorf("Objects cannot be empty!")
+	}
+	if sortKeys {
+		sort.Strings(keys)
+	}
+
+	rows := make([][]interface{}, len(objects))
+	for i, obj := range objects {
+		rows[i] = make([]interface{}, len(keys))
+		for j, k := range keys {
+			v, ok := obj[k]
+			if !ok || v == nil {
+				v = placeholder
+			}
+			rows[i][j] = v
+		}
+	}
+	return NewTableFromRows(title, keys, rows)
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("Expected %v, got %v", delim, tok)
+	}
+	return nil
+}
+
+// decodeJSONObject reads object members after its opening brace and returns
+// them along with keys in their original order.
+func decodeJSONObject(dec *json.Decoder) (map[string]interface{}, []string, error) {
+	obj := make(map[string]interface{})
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key, _ := tok.(string)
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, nil, err
+		}
+		v, err := jsonValue(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := obj[key]; !ok {
+			keys = append(keys, key)
+		}
+		obj[key] = v
+	}
+	if err := expectJSONDelim(dec, '}'); err != nil {
+		return nil, nil, err
+	}
+	return obj, keys, nil
+}
+
+// jsonValue converts raw to a string, bool, int64, float64 or nil. Objects and
+// arrays are kept as compact JSON strings.
+func jsonValue(raw json.RawMessage) (interface{}, error) {
+	raw = bytes.TrimSpace(raw)
+	switch raw[0] {
+	case '{', '[':
+		var b bytes.Buffer
+		if err := json.Compact(&b, raw); err != nil {
+			return nil, err
+		}
+		return b.String(), nil
+	case '"':
+		var s string
+		err := json.Unmarshal(raw, &s)
+		return s, err
+	case 't', 'f':
+		var b bool
+		err := json.Unmarshal(raw, &b)
+		return b, err
+	case 'n':
+		return nil, nil
+	}
+
+	n := json.Number(raw)
+	if i, err := n.Int64(); err == nil {
+		return i, nil
+	}
+	if u, err := strconv.ParseUint(n.String(), 10, 64); err == nil {
+		return u, nil
+	}
+	// Integers too big for 64 bits and floats out of range keep their text.
+	if f, err := n.Float64(); err == nil && strings.ContainsAny(n.String(), ".eE") {
+		return f, nil
+	}
+	return n.String(), nil
+}
@@ -0,0 +1,84 @@
+package tymbol
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTableFromJSON(t *testing.T) {
+	t.Run("Array of objects", func(t *testing.T) {
+		r := strings.NewReader(`[
+			{"name": "Bob", "score": 10, "tags": ["a", "b"]},
+			{"name": "Alice", "score": 9.88, "active": true, "meta": {"x": 1}},
+			{"score": null}
+		]`)
+		tab, err := NewTableFromJSON("Players", r, false, "-")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"name", "score", "tags", "active", "meta"}, tab.headers)
+		assert.Equal(t, [][]string{
+			{"Bob", "Alice", "-"},
+			{"10", "9.88", "-"},
+			{`["a","b"]`, "-", "-"},
+			{"-", "true", "-"},
+			{"-", `{"x":1}`, "-"},
+		}, tab.columns)
+	})
+
+	t.Run("Newline-delimited with sorted keys", func(t *testing.T) {
+		r := strings.NewReader("{\"b\": 1, \"a\": \"x\"}\n{\"c\": 2.5}\n")
+		tab, err := NewTableFromJSON("", r, true, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, tab.headers)
+		assert.Equal(t, [][]string{{"x", ""}, {"1", ""}, {"", "2.5"}}, tab.columns)
+
+		tab.Options.SetCellFitContent(true)
+		want := `#=====#=====#=======#
+#  a  #  b  #   c   #
+#=====#=====#=======#
+|  x  |  1  |       |
++-----+-----+-------+
+|     |     |  2.5  |
++-----+-----+-------+
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Big numbers", func(t *testing.T) {
+		r := strings.NewReader(`[{"n": 18446744073709551615}, {"n": 1e400}, {"n": 123456789012345678901234567890}, {"n": -1.5e3}]`)
+		tab, err := NewTableFromJSON("", r, false, "")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"18446744073709551615", "1e400", "123456789012345678901234567890", "-1500"}}, tab.columns)
+		assert.Equal(t, uint64(18446744073709551615), tab.values[0][0])
+	})
+
+	t.Run("Not objects", func(t *testing.T) {
+		_, err := NewTableFromJSON("", strings.NewReader(`[1, 2]`), false, "")
+		if assert.Error(t, err) {
+			assert.Equal(t, "Expected {, got 1", err.Error())
+		}
+
+		_, err = NewTableFromJSON("", strings.NewReader(`"test"`), false, "")
+		if assert.Error(t, err) {
+			assert.Equal(t, "Expected JSON array or objects, got test", err.Error())
+		}
+	})
+
+	t.Run("Empty input", func(t *testing.T) {
+		_, err := NewTableFromJSON("", strings.NewReader(""), false, "")
+		if assert.Error(t, err) {
+			assert.Equal(t, "Objects cannot be empty!", err.Error())
+		}
+
+		_, err = NewTableFromJSON("", strings.NewReader("[{}]"), false, "")
+		if assert.Error(t, err) {
+			assert.Equal(t, "Objects cannot be empty!", err.Error())
+		}
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		_, err := NewTableFromJSON("", strings.NewReader(`[{"a": }]`), false, "")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,81 @@
+package tymbol
+
+// layout holds measured lengths used to draw a table.
+type layout struct {
+	cellLengths []int
+	paddings    []int
+	tableLength int
+
+	// fracWidths are set for columns with DECIMAL align.
+	fracWidths []int
+
+	// footer is nil if the table has no footer.
+	footer []string
+}
+
+// layout measures the table with its current options. Draw measures the
+// table once and passes the layout to every drawn line.
+func (t *Table) layout() layout {
+	columns := make([]ColumnOptions, len(t.columns))
+	intWidths := make([]int, len(t.columns))
+	fracWidths := make([]int, len(t.columns))
+	for i := range columns {
+		columns[i] = t.ColumnOptions(i)
+		if columns[i].CellAlign() == DECIMAL {
+			var limit int
+			if !columns[i].FitContent() {
+				limit = columns[i].CellLength()
+			}
+			intWidths[i], fracWidths[i] = t.decimalWidths(i, limit)
+		}
+	}
+	return t.measure(columns, intWidths, fracWidths, t.footerRow(), t.spanWidths())
+}
+
+// measure computes content length of every column and the full length of
+// the table. Fit-content columns are widened to hold footer values and, with
+// DECIMAL align, the widest integer and fractional parts. Extra width needed by
+// cells spanning several columns is distributed evenly among them.
+func (t *Table) measure(columns []ColumnOptions, intWidths, fracWidths []int, footer []string, spans []spanWidth) layout {
+	columns = append([]ColumnOptions{}, columns...)
+	for i, c := range columns {
+		if !c.FitContent() {
+			continue
+		}
+		if intWidths[i]+fracWidths[i] > columns[i].cellLength {
+			columns[i].cellLength = intWidths[i] + fracWidths[i]
+		}
+		if footer != nil && blockWidth(footer[i]) > columns[i].cellLength {
+			columns[i].cellLength = blockWidth(footer[i])
+		}
+	}
+	for _, s := range spans {
+		if !columns[s.col].FitContent() {
+			continue
+		}
+		have := 0
+		for k := s.col; k < s.col+s.cols; k++ {
+			have += columns[k].cellLength + 2*columns[k].CellPadding()
+		}
+		have -= 2 * columns[s.col].CellPadding()
+		if t.Options.InnerBorder() {
+			have += s.cols - 1
+		}
+		for k := 0; have < s.width; k++ {
+			columns[s.col+k%s.cols].cellLength++
+			have++
+		}
+	}
+	l := layout{
+		cellLengths: t.fitColumns(columns),
+		paddings:    make([]int, len(columns)),
+		fracWidths:  fracWidths,
+		footer:      footer,
+	}
+	for i, c := range columns {
+		l.paddings[i] = c.CellPadding()
+		l.tableLength += l.cellLengths[i] + 2*l.paddings[i]
+	}
+	l.tableLength += t.bordersLength(len(columns))
+	return l
+}
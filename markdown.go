@@ -0,0 +1,84 @@
+package tymbol
+
+import "strings"
+
+var markdownEscaper = strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>")
+
+// DrawMarkdown renders the table as a GitHub-flavored Markdown table. The
+// title becomes a bold caption line and column aligns are kept in the
+// delimiter row. Markdown requires a header row, so a headerless table gets
+// an empty one.
+func (t *Table) DrawMarkdown() string {
+	var b strings.Builder
+	if t.Title != "" {
+		b.WriteString("**" + markdownEscaper.Replace(stripEscapes(t.Title)) + "**")
+		b.WriteString(NEW_LINE + NEW_LINE)
+	}
+
+	headers := make([]string, len(t.columns))
+	for i := range t.headers {
+		headers[i] = markdownEscaper.Replace(stripEscapes(t.headers[i]))
+	}
+	rows := make([][]string, t.NumRows())
+	for i := range rows {
+		rows[i] = make([]string, len(t.columns))
+		for j := range t.columns {
+			rows[i][j] = markdownEscaper.Replace(stripEscapes(t.columns[j][i]))
+		}
+	}
+
+	widths := make([]int, len(t.columns))
+	for i := range widths {
+		widths[i] = 3
+		if w := stringWidth(headers[i]); w > widths[i] {
+			widths[i] = w
+		}
+		for j := range rows {
+			if w := stringWidth(rows[j][i]); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	aligns := make([]align, len(t.columns))
+	for i := range aligns {
+		c := t.ColumnOptions(i)
+		aligns[i] = c.CellAlign()
+	}
+
+	writeMarkdownRow(&b, headers, widths, aligns)
+	b.WriteString("|")
+	for i := range widths {
+		left, right := "-", "-"
+		switch aligns[i] {
+		case LEFT:
+			left = ":"
+		case RIGHT, DECIMAL:
+			right = ":"
+		case CENTER:
+			left, right = ":", ":"
+		}
+		b.WriteString(SPACE + left + strings.Repeat("-", widths[i]-2) + right + SPACE + "|")
+	}
+	b.WriteString(NEW_LINE)
+	for _, row := range rows {
+		writeMarkdownRow(&b, row, widths, aligns)
+	}
+	return b.String()
+}
+
+func writeMarkdownRow(b *strings.Builder, values []string, widths []int, aligns []align) {
+	b.WriteString("|")
+	for i, v := range values {
+		space := widths[i] - stringWidth(v)
+		var left int
+		switch aligns[i] {
+		case RIGHT, DECIMAL:
+			left = space
+		case CENTER:
+			left = space / 2
+		}
+		b.WriteString(SPACE + strings.Repeat(SPACE, left) + v + strings.Repeat(SPACE, space-left) + SPACE + "|")
+	}
+	b.WriteString(NEW_LINE)
+}
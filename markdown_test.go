@@ -0,0 +1,44 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawMarkdown(t *testing.T) {
+	t.Run("Markdown table", func(t *testing.T) {
+		tab, _ := NewTable(
+			"Players' scores",
+			[]string{"id", "Name", "Score"},
+			[][]interface{}{{1, 2}, {"Bob | Jr.", FgRed.Apply("Alice")}, {10.0, 9.88}},
+		)
+		var id, score ColumnOptions
+		id.SetCellAlign(LEFT)
+		score.SetCellAlign(RIGHT)
+		tab.Options.SetColumnOptions(0, id)
+		tab.Options.SetColumnOptions(2, score)
+
+		want := `**Players' scores**
+
+| id  |    Name    | Score |
+| :-- | :--------: | ----: |
+| 1   | Bob \| Jr. |    10 |
+| 2   |   Alice    |  9.88 |
+`
+		assert.Equal(t, want, tab.DrawMarkdown())
+	})
+
+	t.Run("Headerless table with line breaks", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			nil,
+			[][]interface{}{{"first\nsecond"}},
+		)
+
+		want := "|                 |\n" +
+			"| :-------------: |\n" +
+			"| first<br>second |\n"
+		assert.Equal(t, want, tab.DrawMarkdown())
+	})
+}
@@ -1,14 +1,44 @@
 package tymbol
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
 
 const (
 	LEFT   = "left"
 	RIGHT  = "right"
 	CENTER = "center"
+	// DECIMAL lines up numbers of a column on their decimal point. Titles and
+	// headers are aligned to the right.
+	DECIMAL = "decimal"
+)
+
+var availableAligns = [4]string{LEFT, RIGHT, CENTER, DECIMAL}
+
+const (
+	WRAP_CHAR = "char"
+	WRAP_WORD = "word"
+)
+
+var availableWrapModes = [2]string{WRAP_CHAR, WRAP_WORD}
+
+const (
+	OVERFLOW_WRAP            = "wrap"
+	OVERFLOW_TRUNCATE        = "truncate"
+	OVERFLOW_TRUNCATE_MIDDLE = "truncate-middle"
 )
 
-var availableAligns = [3]string{LEFT, RIGHT, CENTER}
+var availableOverflows = [3]string{OVERFLOW_WRAP, OVERFLOW_TRUNCATE, OVERFLOW_TRUNCATE_MIDDLE}
+
+const (
+	PAGE_ROWS  = "rows"
+	PAGE_LINES = "lines"
+)
+
+var availablePageModes = [2]string{PAGE_ROWS, PAGE_LINES}
 
 type align = string
 
@@ -17,8 +47,13 @@ type Options struct {
 
 	cellFitContent bool
 	cellLength     int
+	minCellLength  int
+	maxWidth       int
 	cellPadding    int
 	cellAlign      align
+	wrapMode       string
+	overflow       string
+	ellipsis       string
 	crossLineSym   rune
 	vLineSym       rune
 	hLineSym       rune
@@ -27,6 +62,27 @@ type Options struct {
 	crossHeaderSym rune
 	hHeaderSym     rune
 	vHeaderSym     rune
+
+	border          BorderStyle
+	hideOuterBorder bool
+	hideInnerBorder bool
+
+	columnOptions       map[int]ColumnOptions
+	headerColumnOptions map[string]ColumnOptions
+
+	titleStyle   Style
+	headerStyle  Style
+	footerStyle  Style
+	frameStyle   Style
+	columnStyles map[int]Style
+	rowStyles    map[int]Style
+	cellStyles   map[cellIndex]Style
+
+	rowHTMLClasses map[int]string
+
+	pageSize   int
+	pageMode   string
+	pageFooter bool
 }
 
 func defaultOptions() Options {
@@ -40,6 +96,9 @@ func defaultOptions() Options {
 		hHeaderSym:     '=',
 		vHeaderSym:     '#',
 		cellAlign:      "center",
+		wrapMode:       WRAP_CHAR,
+		overflow:       OVERFLOW_WRAP,
+		ellipsis:       "…",
 		crossLineSym:   '+',
 		hLineSym:       '-',
 		vLineSym:       '|',
@@ -55,6 +114,33 @@ func checkAlignOption(a string) bool {
 	return false
 }
 
+func checkWrapModeOption(m string) bool {
+	for i := 0; i < len(availableWrapModes); i++ {
+		if m == availableWrapModes[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func checkOverflowOption(m string) bool {
+	for i := 0; i < len(availableOverflows); i++ {
+		if m == availableOverflows[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func checkPageModeOption(m string) bool {
+	for i := 0; i < len(availablePageModes); i++ {
+		if m == availablePageModes[i] {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *Options) TitleAlign() string {
 	return o.titleAlign
 }
@@ -91,6 +177,55 @@ func (o *Options) SetCellLength(l int) error {
 	return nil
 }
 
+func (o *Options) MinCellLength() int {
+	return o.minCellLength
+}
+
+// SetMinCellLength sets how narrow columns may become when the table is
+// shrunk to fit MaxWidth.
+func (o *Options) SetMinCellLength(l int) error {
+	if l <= 0 {
+		return fmt.Errorf("Value must be greater than 0")
+	}
+	o.minCellLength = l
+	return nil
+}
+
+func (o *Options) MaxWidth() int {
+	return o.maxWidth
+}
+
+// SetMaxWidth limits total width of the table. The widest columns are shrunk
+// first, down to MinCellLength, and their values are wrapped or truncated.
+// Zero means no limit.
+func (o *Options) SetMaxWidth(w int) error {
+	if w < 0 {
+		return fmt.Errorf("Value must be positive")
+	}
+	o.maxWidth = w
+	return nil
+}
+
+// SetMaxWidthFromTerminal limits the table to the width of the terminal w
+// writes to. If w isn't a terminal, the COLUMNS environment variable is used.
+func (o *Options) SetMaxWidthFromTerminal(w io.Writer) error {
+	if f, ok := w.(interface{ Fd() uintptr }); ok {
+		if width, ok := terminalWidth(f.Fd()); ok {
+			return o.SetMaxWidth(width)
+		}
+	}
+
+	columns := os.Getenv("COLUMNS")
+	if columns == "" {
+		return fmt.Errorf("Unable to detect terminal width")
+	}
+	width, err := strconv.Atoi(columns)
+	if err != nil || width <= 0 {
+		return fmt.Errorf("Invalid COLUMNS value: %s", columns)
+	}
+	return o.SetMaxWidth(width)
+}
+
 func (o *Options) CellFitContent() bool {
 	return o.cellFitContent
 }
@@ -124,12 +259,82 @@ func (o *Options) SetCellAlign(a align) error {
 	return nil
 }
 
+func (o *Options) WrapMode() string {
+	return o.wrapMode
+}
+
+// SetWrapMode sets how values longer than CellLength are broken into lines:
+// WRAP_CHAR breaks at any character, WRAP_WORD breaks on whitespace and
+// splits only words longer than the cell.
+func (o *Options) SetWrapMode(m string) error {
+	if ok := checkWrapModeOption(m); !ok {
+		return fmt.Errorf("Unknown wrap mode. Expected %v, got %s", availableWrapModes, m)
+	}
+	o.wrapMode = m
+	return nil
+}
+
+func (o *Options) Overflow() string {
+	return o.overflow
+}
+
+// SetOverflow sets what happens to values that don't fit into a cell:
+// OVERFLOW_WRAP breaks them into several lines, OVERFLOW_TRUNCATE cuts their
+// end and OVERFLOW_TRUNCATE_MIDDLE cuts their middle, so every row takes
+// exactly one line.
+func (o *Options) SetOverflow(m string) error {
+	if ok := checkOverflowOption(m); !ok {
+		return fmt.Errorf("Unknown overflow mode. Expected %v, got %s", availableOverflows, m)
+	}
+	o.overflow = m
+	return nil
+}
+
+func (o *Options) Ellipsis() string {
+	return o.ellipsis
+}
+
+// SetEllipsis sets the symbols that replace the cut part of truncated values.
+func (o *Options) SetEllipsis(e string) error {
+	o.ellipsis = e
+	return nil
+}
+
+// SetColumnOptions overrides options of the column with index col.
+func (o *Options) SetColumnOptions(col int, c ColumnOptions) error {
+	if col < 0 {
+		return fmt.Errorf("Value must be positive")
+	}
+	if o.columnOptions == nil {
+		o.columnOptions = make(map[int]ColumnOptions)
+	}
+	o.columnOptions[col] = c
+	return nil
+}
+
+// SetColumnOptionsByHeader overrides options of the column with the given
+// header. Options set by index take precedence.
+func (o *Options) SetColumnOptionsByHeader(header string, c ColumnOptions) error {
+	if o.headerColumnOptions == nil {
+		o.headerColumnOptions = make(map[string]ColumnOptions)
+	}
+	o.headerColumnOptions[header] = c
+	return nil
+}
+
 func (o *Options) CrossHeaderSym() rune {
 	return o.crossHeaderSym
 }
 
+// SetCrossHeaderSym sets junctions of header and footer lines. With a border
+// style set it replaces their corners too, so rounded or box corners are lost.
 func (o *Options) SetCrossHeaderSym(s rune) error {
 	o.crossHeaderSym = s
+	if o.hasBorderStyle() {
+		for _, l := range []*BorderLine{&o.border.HeaderTop, &o.border.HeaderBottom, &o.border.FooterTop, &o.border.FooterBottom} {
+			l.Left, l.Cross, l.Right = s, s, s
+		}
+	}
 	return nil
 }
 
@@ -139,6 +344,10 @@ func (o *Options) HHeaderSym() rune {
 
 func (o *Options) SetHHeaderSym(s rune) error {
 	o.hHeaderSym = s
+	if o.hasBorderStyle() {
+		o.border.HeaderTop.Line, o.border.HeaderBottom.Line = s, s
+		o.border.FooterTop.Line, o.border.FooterBottom.Line = s, s
+	}
 	return nil
 }
 func (o *Options) VHeaderSym() rune {
@@ -147,6 +356,10 @@ func (o *Options) VHeaderSym() rune {
 
 func (o *Options) SetVHeaderSym(s rune) error {
 	o.vHeaderSym = s
+	if o.hasBorderStyle() {
+		o.border.Header = BorderVertical{s, s, s}
+		o.border.Footer = BorderVertical{s, s, s}
+	}
 	return nil
 }
 
@@ -154,8 +367,15 @@ func (o *Options) CrossLineSym() rune {
 	return o.crossLineSym
 }
 
+// SetCrossLineSym sets junctions of body lines. With a border style set it
+// replaces their corners too.
 func (o *Options) SetCrossLineSym(s rune) error {
 	o.crossLineSym = s
+	if o.hasBorderStyle() {
+		for _, l := range []*BorderLine{&o.border.Top, &o.border.Row, &o.border.Bottom} {
+			l.Left, l.Cross, l.Right = s, s, s
+		}
+	}
 	return nil
 }
 
@@ -165,6 +385,9 @@ func (o *Options) VLineSym() rune {
 
 func (o *Options) SetVLineSym(s rune) error {
 	o.vLineSym = s
+	if o.hasBorderStyle() {
+		o.border.Body = BorderVertical{s, s, s}
+	}
 	return nil
 }
 
@@ -174,5 +397,198 @@ func (o *Options) HLineSym() rune {
 
 func (o *Options) SetHLineSym(s rune) error {
 	o.hLineSym = s
+	if o.hasBorderStyle() {
+		o.border.Top.Line, o.border.Row.Line, o.border.Bottom.Line = s, s, s
+	}
+	return nil
+}
+
+func (o *Options) TitleStyle() Style {
+	return o.titleStyle
+}
+
+func (o *Options) SetTitleStyle(s Style) error {
+	o.titleStyle = s
+	return nil
+}
+
+func (o *Options) HeaderStyle() Style {
+	return o.headerStyle
+}
+
+func (o *Options) SetHeaderStyle(s Style) error {
+	o.headerStyle = s
+	return nil
+}
+
+func (o *Options) FooterStyle() Style {
+	return o.footerStyle
+}
+
+func (o *Options) SetFooterStyle(s Style) error {
+	o.footerStyle = s
+	return nil
+}
+
+// FrameStyle is applied to every border symbol of the table.
+func (o *Options) FrameStyle() Style {
+	return o.frameStyle
+}
+
+func (o *Options) SetFrameStyle(s Style) error {
+	o.frameStyle = s
+	return nil
+}
+
+func (o *Options) ColumnStyle(col int) Style {
+	return o.columnStyles[col]
+}
+
+func (o *Options) SetColumnStyle(col int, s Style) error {
+	if col < 0 {
+		return fmt.Errorf("Value must be positive")
+	}
+	if o.columnStyles == nil {
+		o.columnStyles = make(map[int]Style)
+	}
+	o.columnStyles[col] = s
+	return nil
+}
+
+func (o *Options) RowStyle(row int) Style {
+	return o.rowStyles[row]
+}
+
+func (o *Options) SetRowStyle(row int, s Style) error {
+	if row < 0 {
+		return fmt.Errorf("Value must be positive")
+	}
+	if o.rowStyles == nil {
+		o.rowStyles = make(map[int]Style)
+	}
+	o.rowStyles[row] = s
+	return nil
+}
+
+func (o *Options) CellStyle(row, col int) Style {
+	return o.cellStyles[cellIndex{row, col}]
+}
+
+func (o *Options) SetCellStyle(row, col int, s Style) error {
+	if row < 0 || col < 0 {
+		return fmt.Errorf("Value must be positive")
+	}
+	if o.cellStyles == nil {
+		o.cellStyles = make(map[cellIndex]Style)
+	}
+	o.cellStyles[cellIndex{row, col}] = s
+	return nil
+}
+
+// bodyStyle combines column, row and cell styles of a body cell. Later
+// parameters take precedence when they conflict.
+func (o *Options) bodyStyle(row, col int) Style {
+	return NewStyle(o.ColumnStyle(col), o.RowStyle(row), o.CellStyle(row, col))
+}
+
+func (o *Options) hasBorderStyle() bool {
+	return o.border != BorderStyle{}
+}
+
+// BorderStyle returns symbols used to draw borders. Unless SetBorderStyle was
+// called they are built from CrossHeaderSym, HLineSym and the other symbols.
+func (o *Options) BorderStyle() BorderStyle {
+	if o.hasBorderStyle() {
+		return o.border
+	}
+	return o.legacyBorderStyle()
+}
+
+// SetBorderStyle replaces every border symbol, e.g. with one of the presets
+// like BorderLight or BorderDouble. Symbol setters like SetVLineSym still
+// change the corresponding symbols afterwards.
+func (o *Options) SetBorderStyle(b BorderStyle) error {
+	o.border = b
+	o.crossHeaderSym = b.HeaderBottom.Cross
+	o.hHeaderSym = b.HeaderBottom.Line
+	o.vHeaderSym = b.Header.Middle
+	o.crossLineSym = b.Row.Cross
+	o.hLineSym = b.Row.Line
+	o.vLineSym = b.Body.Middle
+	return nil
+}
+
+func (o *Options) OuterBorder() bool {
+	return !o.hideOuterBorder
+}
+
+// SetOuterBorder toggles the border around the table.
+func (o *Options) SetOuterBorder(b bool) error {
+	o.hideOuterBorder = !b
+	return nil
+}
+
+func (o *Options) InnerBorder() bool {
+	return !o.hideInnerBorder
+}
+
+// SetInnerBorder toggles borders between columns and between body rows.
+func (o *Options) SetInnerBorder(b bool) error {
+	o.hideInnerBorder = !b
+	return nil
+}
+
+func (o *Options) RowHTMLClass(row int) string {
+	return o.rowHTMLClasses[row]
+}
+
+// SetRowHTMLClass sets class attribute of the body row in DrawHTML output.
+func (o *Options) SetRowHTMLClass(row int, class string) error {
+	if row < 0 {
+		return fmt.Errorf("Value must be positive")
+	}
+	if o.rowHTMLClasses == nil {
+		o.rowHTMLClasses = make(map[int]string)
+	}
+	o.rowHTMLClasses[row] = class
+	return nil
+}
+
+func (o *Options) PageSize() int {
+	return o.pageSize
+}
+
+// SetPageSize splits the table into pages of n rows or n output lines,
+// depending on PageMode. Zero turns pagination off.
+func (o *Options) SetPageSize(n int) error {
+	if n < 0 {
+		return fmt.Errorf("Value must be positive")
+	}
+	o.pageSize = n
+	return nil
+}
+
+func (o *Options) PageMode() string {
+	if o.pageMode == "" {
+		return PAGE_ROWS
+	}
+	return o.pageMode
+}
+
+func (o *Options) SetPageMode(m string) error {
+	if ok := checkPageModeOption(m); !ok {
+		return fmt.Errorf("Unknown page mode. Expected %v, got %s", availablePageModes, m)
+	}
+	o.pageMode = m
+	return nil
+}
+
+func (o *Options) PageFooter() bool {
+	return o.pageFooter
+}
+
+// SetPageFooter toggles the "page 2/7" line below every page.
+func (o *Options) SetPageFooter(b bool) error {
+	o.pageFooter = b
 	return nil
 }
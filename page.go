@@ -0,0 +1,166 @@
+package tymbol
+
+import (
+	"fmt"
+	"strings"
+)
+
+type pageRange struct {
+	from, to int
+}
+
+// pages splits body rows into pages of PageSize rows or PageSize output
+// lines. Every page holds at least one row.
+func (r *renderer) pages(border BorderStyle) []pageRange {
+	rows := r.t.NumRows()
+	size := r.t.Options.PageSize()
+	if size == 0 || rows == 0 {
+		return []pageRange{{0, rows}}
+	}
+
+	var pages []pageRange
+	if r.t.Options.PageMode() == PAGE_ROWS {
+		for from := 0; from < rows; from += size {
+			to := from + size
+			if to > rows {
+				to = rows
+			}
+			pages = append(pages, pageRange{from, to})
+		}
+		return pages
+	}
+
+	outer := r.t.Options.OuterBorder()
+	fixed := 0
+	if r.t.Title != "" {
+		fixed++
+	}
+	if len(r.t.headers) > 0 {
+		if outer && border.HeaderTop.Line != 0 {
+			fixed++
+		}
+		fixed += r.rowHeight(r.t.headers)
+		if border.HeaderBottom.Line != 0 {
+			fixed++
+		}
+		// Bands of a header tree take a line and a separator each.
+		if owners, _ := r.t.headerGrid(); len(owners) > 1 {
+			fixed += 2 * (len(owners) - 1)
+		}
+	} else if outer && border.Top.Line != 0 {
+		fixed++
+	}
+	if outer && border.Bottom.Line != 0 {
+		fixed++
+	}
+	if r.t.Options.PageFooter() {
+		fixed++
+	}
+	if r.l.footer != nil {
+		// The last page may hold the footer, it's reserved on every page.
+		fixed += r.rowHeight(r.l.footer)
+		if border.FooterTop.Line != 0 {
+			fixed++
+		}
+	}
+	separator := 0
+	if r.t.Options.InnerBorder() && border.Row.Line != 0 {
+		separator = 1
+	}
+
+	from, lines := 0, fixed
+	for i := 0; i < rows; i++ {
+		height := r.rowHeight(r.t.row(i))
+		if i > from {
+			height += separator
+		}
+		if i > from && lines+height > size {
+			pages = append(pages, pageRange{from, i})
+			from, lines = i, fixed
+			height -= separator
+		}
+		lines += height
+	}
+	return append(pages, pageRange{from, rows})
+}
+
+// rowHeight returns the number of lines the row of values takes.
+func (r *renderer) rowHeight(values []string) int {
+	height := 1
+	for i, v := range values {
+		if n := len(r.cellLines(i, v)); n > height {
+			height = n
+		}
+	}
+	return height
+}
+
+// drawPage draws the title, headers, rows and the footer of the page with
+// index i.
+func (r *renderer) drawPage(border BorderStyle, pages []pageRange, i int) {
+	r.drawTitle()
+	r.drawHeader(border)
+	r.drawRows(border, pages[i].from, pages[i].to)
+	if r.t.Options.PageFooter() {
+		r.drawCaption(fmt.Sprintf("page %d/%d", i+1, len(pages)), RIGHT, "")
+	}
+}
+
+// PageIterator returns pages of a table one at a time.
+type PageIterator struct {
+	t      *Table
+	l      layout
+	border BorderStyle
+	pages  []pageRange
+	idx    int
+	page   string
+}
+
+// Pages returns an iterator over pages of the table. A table without
+// PageSize has a single page.
+//
+//	it := table.Pages()
+//	for it.Next() {
+//		fmt.Print(it.Page())
+//	}
+func (t *Table) Pages() *PageIterator {
+	r := renderer{t: t, l: t.layout()}
+	border := t.Options.BorderStyle()
+	return &PageIterator{
+		t:      t,
+		l:      r.l,
+		border: border,
+		pages:  r.pages(border),
+		idx:    -1,
+	}
+}
+
+// Next draws the next page, it returns false when there are no pages left.
+func (p *PageIterator) Next() bool {
+	if p.idx+1 >= len(p.pages) {
+		p.page = ""
+		return false
+	}
+	p.idx++
+
+	var b strings.Builder
+	r := renderer{t: p.t, l: p.l, w: &b}
+	r.drawPage(p.border, p.pages, p.idx)
+	p.page = b.String()
+	return true
+}
+
+// Page returns the page drawn by the last call of Next.
+func (p *PageIterator) Page() string {
+	return p.page
+}
+
+// Number returns the number of the current page, starting from 1.
+func (p *PageIterator) Number() int {
+	return p.idx + 1
+}
+
+// Count returns the total number of pages.
+func (p *PageIterator) Count() int {
+	return len(p.pages)
+}
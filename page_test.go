@@ -0,0 +1,141 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagination(t *testing.T) {
+	t.Run("Pages of rows", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, "Bob"}, {2, "Alice\nSmith"}, {3, "Francis"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetPageSize(2)
+		tab.Options.SetPageFooter(true)
+
+		want := `#====#=========#
+# id #  name   #
+#====#=========#
+| 1  |   Bob   |
++----+---------+
+| 2  |  Alice  |
+|    |  Smith  |
++----+---------+
+        page 1/2
+#====#=========#
+# id #  name   #
+#====#=========#
+| 3  | Francis |
++----+---------+
+        page 2/2
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Spans across pages", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"group", "n"},
+			[][]interface{}{{"A", 1}, {"A", 4}, {"B", 7}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetBorderStyle(BorderLight)
+		tab.SetSpan(0, 0, 2, 1)
+		tab.Options.SetPageSize(1)
+
+		want := `┌───────┬───┐
+│ group │ n │
+├───────┼───┤
+│   A   │ 1 │
+└───────┴───┘
+┌───────┬───┐
+│ group │ n │
+├───────┼───┤
+│   A   │ 4 │
+└───────┴───┘
+┌───────┬───┐
+│ group │ n │
+├───────┼───┤
+│   B   │ 7 │
+└───────┴───┘
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Pages of lines", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, "Bob"}, {2, "Alice\nSmith"}, {3, "Francis"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetPageMode(PAGE_LINES)
+		tab.Options.SetPageSize(7)
+
+		want := `#====#=========#
+# id #  name   #
+#====#=========#
+| 1  |   Bob   |
++----+---------+
+#====#=========#
+# id #  name   #
+#====#=========#
+| 2  |  Alice  |
+|    |  Smith  |
++----+---------+
+#====#=========#
+# id #  name   #
+#====#=========#
+| 3  | Francis |
++----+---------+
+`
+		assert.Equal(t, want, tab.Draw())
+
+		tab.Options.SetPageSize(8)
+		it := tab.Pages()
+		assert.Equal(t, 2, it.Count())
+	})
+
+	t.Run("Iterator", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, "Bob"}, {2, "Alice\nSmith"}, {3, "Francis"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetPageSize(1)
+
+		it := tab.Pages()
+		assert.Equal(t, 3, it.Count())
+		var pages []string
+		for it.Next() {
+			pages = append(pages, it.Page())
+			assert.Equal(t, len(pages), it.Number())
+		}
+		assert.Len(t, pages, 3)
+		want := `#====#=========#
+# id #  name   #
+#====#=========#
+| 3  | Francis |
++----+---------+
+`
+		assert.Equal(t, want, pages[2])
+		assert.False(t, it.Next())
+		assert.Equal(t, "", it.Page())
+	})
+
+	t.Run("Invalid options", func(t *testing.T) {
+		var o Options
+		assert.Error(t, o.SetPageSize(-1))
+		assert.Error(t, o.SetPageMode("screens"))
+		assert.Equal(t, PAGE_ROWS, o.PageMode())
+	})
+}
@@ -0,0 +1,99 @@
+package tymbol
+
+import "fmt"
+
+// NewTableFromRows creates a table from row-oriented data. Rows may be empty
+// if headers are given, so the table can be filled later with AppendRow.
+func NewTableFromRows(title string, headers []string, rows [][]interface{}) (Table, error) {
+	if len(rows) == 0 && len(headers) == 0 {
+		return Table{}, fmt.Errorf("Rows cannot be empty!")
+	}
+
+	numberOfColumns := len(headers)
+	if len(rows) > 0 {
+		numberOfColumns = len(rows[0])
+	}
+	for i := range rows {
+		if len(rows[i]) != numberOfColumns {
+			return Table{}, fmt.Errorf("Rows must be same length. Assumed len: %d. Diff len row index: %d", numberOfColumns, i)
+		}
+	}
+
+	columns := make([][]interface{}, numberOfColumns)
+	for i := range columns {
+		columns[i] = make([]interface{}, len(rows))
+		for j := range rows {
+			columns[i][j] = rows[j][i]
+		}
+	}
+	return NewTable(title, headers, columns)
+}
+
+func (t *Table) headerOffset() int {
+	if len(t.headers) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// NumRows returns the number of rows in the table body.
+func (t *Table) NumRows() int {
+	if len(t.columns) == 0 {
+		return 0
+	}
+	return len(t.columns[0])
+}
+
+func (t *Table) AppendRow(values ...interface{}) error {
+	return t.InsertRow(t.NumRows(), values...)
+}
+
+// InsertRow inserts a row before the row with index idx.
+func (t *Table) InsertRow(idx int, values ...interface{}) error {
+	if idx < 0 || idx > t.NumRows() {
+		return fmt.Errorf("Row index out of range: %d", idx)
+	}
+	if len(values) != len(t.columns) {
+		return fmt.Errorf("Number of values and columns don't match: %d %d", len(values), len(t.columns))
+	}
+
+	var rowLength int
+	for i := range values {
+		val := t.formatValue(i, values[i])
+		width := blockWidth(val)
+		if t.maxColLength[i] < width {
+			t.maxColLength[i] = width
+		}
+		if rowLength < width {
+			rowLength = width
+		}
+		t.columns[i] = append(t.columns[i], "")
+		copy(t.columns[i][idx+1:], t.columns[i][idx:])
+		t.columns[i][idx] = val
+
+		t.values[i] = append(t.values[i], nil)
+		copy(t.values[i][idx+1:], t.values[i][idx:])
+		t.values[i][idx] = values[i]
+	}
+
+	rowIdx := idx + t.headerOffset()
+	t.maxRowLength = append(t.maxRowLength, 0)
+	copy(t.maxRowLength[rowIdx+1:], t.maxRowLength[rowIdx:])
+	t.maxRowLength[rowIdx] = rowLength
+	t.insertSpanRow(idx)
+	return nil
+}
+
+func (t *Table) RemoveRow(idx int) error {
+	if idx < 0 || idx >= t.NumRows() {
+		return fmt.Errorf("Row index out of range: %d", idx)
+	}
+
+	for i := range t.columns {
+		t.columns[i] = append(t.columns[i][:idx], t.columns[i][idx+1:]...)
+		t.values[i] = append(t.values[i][:idx], t.values[i][idx+1:]...)
+	}
+	t.removeSpanRow(idx)
+	t.measureContent()
+	return nil
+}
@@ -0,0 +1,86 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTableFromRows(t *testing.T) {
+	t.Run("Successful creation", func(t *testing.T) {
+		got, err := NewTableFromRows(
+			"test",
+			[]string{"h1", "h2"},
+			[][]interface{}{{"c11", "c21"}, {"c12", "c22"}, {"c13", "c23"}},
+		)
+		assert.NoError(t, err)
+
+		want, _ := NewTable(
+			"test",
+			[]string{"h1", "h2"},
+			[][]interface{}{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}},
+		)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Rows are different size", func(t *testing.T) {
+		_, err := NewTableFromRows(
+			"test",
+			[]string{},
+			[][]interface{}{{"c11", "c21"}, {"c12"}},
+		)
+		if assert.Error(t, err) {
+			assert.Equal(t, "Rows must be same length. Assumed len: 2. Diff len row index: 1", err.Error())
+		}
+	})
+
+	t.Run("Rows and headers are empty", func(t *testing.T) {
+		_, err := NewTableFromRows("test", nil, nil)
+		if assert.Error(t, err) {
+			assert.Equal(t, "Rows cannot be empty!", err.Error())
+		}
+	})
+}
+
+func TestModifyRows(t *testing.T) {
+	tab, err := NewTableFromRows("", []string{"id", "name"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, tab.NumRows())
+
+	assert.NoError(t, tab.AppendRow(1, "Bob"))
+	assert.NoError(t, tab.AppendRow(3, "Francis"))
+	assert.NoError(t, tab.InsertRow(1, 2, "Alice"))
+	assert.Equal(t, [][]string{{"1", "2", "3"}, {"Bob", "Alice", "Francis"}}, tab.columns)
+	assert.Equal(t, []int{2, 7}, tab.maxColLength)
+	assert.Equal(t, []int{4, 3, 5, 7}, tab.maxRowLength)
+
+	err = tab.AppendRow(4)
+	if assert.Error(t, err) {
+		assert.Equal(t, "Number of values and columns don't match: 1 2", err.Error())
+	}
+	err = tab.InsertRow(5, 4, "Dan")
+	if assert.Error(t, err) {
+		assert.Equal(t, "Row index out of range: 5", err.Error())
+	}
+
+	assert.NoError(t, tab.RemoveRow(2))
+	assert.Equal(t, [][]string{{"1", "2"}, {"Bob", "Alice"}}, tab.columns)
+	assert.Equal(t, []int{2, 5}, tab.maxColLength)
+	assert.Equal(t, []int{4, 3, 5}, tab.maxRowLength)
+
+	err = tab.RemoveRow(2)
+	if assert.Error(t, err) {
+		assert.Equal(t, "Row index out of range: 2", err.Error())
+	}
+
+	tab.Options.SetCellFitContent(true)
+	want := `#======#=========#
+#  id  #  name   #
+#======#=========#
+|  1   |   Bob   |
++------+---------+
+|  2   |  Alice  |
++------+---------+
+`
+	assert.Equal(t, want, tab.Draw())
+}
@@ -0,0 +1,206 @@
+package tymbol
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SortKey describes a single key of multi-key sorting. Compare is optional,
+// it returns a negative number if a goes before b, a positive number if a
+// goes after b and zero if they are equal.
+type SortKey struct {
+	Column  int
+	Desc    bool
+	Compare func(a, b interface{}) int
+}
+
+// SortBy sorts rows by values of the column with index column.
+func (t *Table) SortBy(column int, desc bool) error {
+	return t.SortByKeys(SortKey{Column: column, Desc: desc})
+}
+
+// SortByKeys sorts rows by several keys, later keys are used when values of
+// previous ones are equal. Sorting is stable and compares original values:
+// numbers numerically, times chronologically and anything else by its string
+// representation.
+func (t *Table) SortByKeys(keys ...SortKey) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("Sort keys cannot be empty!")
+	}
+	for _, k := range keys {
+		if k.Column < 0 || k.Column >= len(t.columns) {
+			return fmt.Errorf("Column index out of range: %d", k.Column)
+		}
+	}
+
+	order := make([]int, t.NumRows())
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		for _, k := range keys {
+			compare := k.Compare
+			if compare == nil {
+				compare = compareValues
+			}
+			c := compare(t.values[k.Column][order[i]], t.values[k.Column][order[j]])
+			if k.Desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	t.reorderRows(order)
+	return nil
+}
+
+// reorderRows moves the row with index order[i] to index i together with
+// styles, HTML classes and spans of its cells.
+func (t *Table) reorderRows(order []int) {
+	for i := range t.columns {
+		columns := make([]string, len(order))
+		values := make([]interface{}, len(order))
+		for j, idx := range order {
+			columns[j] = t.columns[i][idx]
+			values[j] = t.values[i][idx]
+		}
+		t.columns[i], t.values[i] = columns, values
+	}
+
+	offset := t.headerOffset()
+	rowLengths := append([]int{}, t.maxRowLength[:offset]...)
+	for _, idx := range order {
+		rowLengths = append(rowLengths, t.maxRowLength[idx+offset])
+	}
+	t.maxRowLength = rowLengths
+
+	rows := make(map[int]int, len(order))
+	for i, idx := range order {
+		rows[idx] = i
+	}
+	t.Options.rowStyles = remapKeys(t.Options.rowStyles, rows)
+	t.Options.rowHTMLClasses = remapKeys(t.Options.rowHTMLClasses, rows)
+	if t.Options.cellStyles != nil {
+		cellStyles := make(map[cellIndex]Style, len(t.Options.cellStyles))
+		for k, v := range t.Options.cellStyles {
+			if row, ok := rows[k.row]; ok {
+				cellStyles[cellIndex{row, k.col}] = v
+			}
+		}
+		t.Options.cellStyles = cellStyles
+	}
+	t.reorderSpans(rows)
+}
+
+// compareValues compares numbers numerically, times chronologically and
+// anything else by its string representation. Numbers go before other values
+// and NaN goes after other numbers.
+func compareValues(a, b interface{}) int {
+	_, aNum := toFloat(a)
+	_, bNum := toFloat(b)
+	switch {
+	case aNum && bNum:
+		return compareNumbers(reflect.ValueOf(a), reflect.ValueOf(b))
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Compare(bt)
+		}
+	}
+	return strings.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+}
+
+// compareNumbers compares integers without converting them to float64, so
+// large integers keep their order.
+func compareNumbers(a, b reflect.Value) int {
+	switch {
+	case isFloatKind(a.Kind()) || isFloatKind(b.Kind()):
+		return compareFloats(a, b)
+	case isUintKind(a.Kind()) && isUintKind(b.Kind()):
+		return compareOrdered(a.Uint(), b.Uint())
+	case isUintKind(a.Kind()):
+		return -compareIntUint(b.Int(), a.Uint())
+	case isUintKind(b.Kind()):
+		return compareIntUint(a.Int(), b.Uint())
+	}
+	return compareOrdered(a.Int(), b.Int())
+}
+
+func compareIntUint(i int64, u uint64) int {
+	if i < 0 {
+		return -1
+	}
+	return compareOrdered(uint64(i), u)
+}
+
+// compareFloats compares numbers of which at least one is a float. Equal
+// floats are compared again as integers if both are whole numbers.
+func compareFloats(a, b reflect.Value) int {
+	af, _ := toFloat(a.Interface())
+	bf, _ := toFloat(b.Interface())
+	aNaN, bNaN := math.IsNaN(af), math.IsNaN(bf)
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN:
+		return 1
+	case bNaN:
+		return -1
+	case af != bf:
+		return compareOrdered(af, bf)
+	case isFloatKind(a.Kind()) && isFloatKind(b.Kind()):
+		return 0
+	}
+	// A float and an integer are equal as floats, the integer may still be
+	// bigger or smaller than the float by less than float64 precision.
+	f, n := af, b
+	sign := 1
+	if !isFloatKind(a.Kind()) {
+		f, n, sign = bf, a, -1
+	}
+	switch {
+	case math.Abs(f) < 1<<53 || math.IsInf(f, 0):
+		return 0
+	case isUintKind(n.Kind()) && f >= 1<<64, !isUintKind(n.Kind()) && f >= 1<<63:
+		return sign
+	case isUintKind(n.Kind()) && f >= 1<<63:
+		return sign * compareOrdered(uint64(f), n.Uint())
+	case isUintKind(n.Kind()):
+		return sign * compareIntUint(int64(f), n.Uint())
+	}
+	return sign * compareOrdered(int64(f), n.Int())
+}
+
+func compareOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+func isUintKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package tymbol
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortBy(t *testing.T) {
+	t.Run("Numeric ascending", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"name", "score"},
+			[][]interface{}{{"Bob", 10}, {"alice", 9.5}, {"Francis", 100}, {"Dave", 9.5}},
+		)
+		err := tab.SortBy(1, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"alice", "Dave", "Bob", "Francis"}, tab.columns[0])
+		assert.Equal(t, []string{"9.5", "9.5", "10", "100"}, tab.columns[1])
+		assert.Equal(t, []interface{}{9.5, 9.5, 10, 100}, tab.values[1])
+		assert.Equal(t, []int{5, 5, 4, 3, 7}, tab.maxRowLength)
+	})
+
+	t.Run("Lexical descending", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"name", "score"},
+			[][]interface{}{{"Bob", 10}, {"alice", 9.5}, {"Francis", 100}, {"Dave", 9.5}},
+		)
+		tab.SortBy(0, true)
+		assert.Equal(t, []string{"alice", "Francis", "Dave", "Bob"}, tab.columns[0])
+	})
+
+	t.Run("Multiple keys with comparator", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"name", "score"},
+			[][]interface{}{{"Bob", 10}, {"alice", 9.5}, {"Francis", 100}, {"Dave", 9.5}},
+		)
+		err := tab.SortByKeys(
+			SortKey{Column: 1, Desc: true},
+			SortKey{Column: 0, Compare: func(a, b interface{}) int {
+				return strings.Compare(strings.ToLower(a.(string)), strings.ToLower(b.(string)))
+			}},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Francis", "Bob", "alice", "Dave"}, tab.columns[0])
+	})
+
+	t.Run("Styles follow rows", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"name", "status"},
+			[][]interface{}{{"b", "ok"}, {"c", "failed"}, {"a", "ok"}},
+		)
+		tab.Options.SetRowStyle(1, FgRed)
+		tab.Options.SetCellStyle(0, 1, Bold)
+		tab.Options.SetRowHTMLClass(1, "failed")
+
+		err := tab.SortBy(0, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, tab.columns[0])
+		assert.Equal(t, FgRed, tab.Options.RowStyle(2))
+		assert.Equal(t, Style(""), tab.Options.RowStyle(1))
+		assert.Equal(t, Bold, tab.Options.CellStyle(1, 1))
+		assert.Equal(t, Style(""), tab.Options.CellStyle(0, 1))
+		assert.Equal(t, "failed", tab.Options.RowHTMLClass(2))
+	})
+
+	t.Run("Numbers before strings", func(t *testing.T) {
+		assert.Equal(t, -1, compareValues(uint8(3), "2"))
+		assert.Equal(t, 1, compareValues("-", 2.5))
+		assert.Equal(t, 0, compareValues(int64(2), 2.0))
+	})
+
+	t.Run("Large integers and NaN", func(t *testing.T) {
+		assert.Equal(t, 1, compareValues(int64(9007199254740993), int64(9007199254740992)))
+		assert.Equal(t, -1, compareValues(uint64(math.MaxUint64-1), uint64(math.MaxUint64)))
+		assert.Equal(t, 1, compareValues(uint64(math.MaxInt64+1), int64(math.MaxInt64)))
+		assert.Equal(t, -1, compareValues(-1, uint(0)))
+		assert.Equal(t, 1, compareValues(int64(9007199254740993), 9007199254740992.0))
+		assert.Equal(t, -1, compareValues(9007199254740992.0, uint64(9007199254740993)))
+		assert.Equal(t, 1, compareValues(math.NaN(), math.Inf(1)))
+		assert.Equal(t, -1, compareValues(3, math.NaN()))
+		assert.Equal(t, 0, compareValues(math.NaN(), math.NaN()))
+		assert.Equal(t, -1, compareValues(math.NaN(), "a"))
+	})
+
+	t.Run("Invalid keys", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"name", "score"},
+			[][]interface{}{{"Bob", 10}, {"alice", 9.5}, {"Francis", 100}, {"Dave", 9.5}},
+		)
+		assert.Error(t, tab.SortByKeys())
+		err := tab.SortBy(2, false)
+		if assert.Error(t, err) {
+			assert.Equal(t, "Column index out of range: 2", err.Error())
+		}
+	})
+}
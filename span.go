@@ -0,0 +1,529 @@
+package tymbol
+
+import "fmt"
+
+type cellSpan struct {
+	rows, cols int
+}
+
+// spanWidth is the width a spanning cell needs across its columns.
+type spanWidth struct {
+	col, cols, width int
+}
+
+// SetSpan merges the body cell at row and col with cells to the right and
+// below it, so it spans rows rows and cols columns. Values of covered cells
+// aren't drawn. Spans of one row and one column remove the previous span.
+// Spans move with inserted, removed and sorted rows. Rows split apart by
+// sorting leave the span, and spans aren't copied to views.
+func (t *Table) SetSpan(row, col, rows, cols int) error {
+	if row < 0 || row >= t.NumRows() {
+		return fmt.Errorf("Row index out of range: %d", row)
+	}
+	if col < 0 || col >= len(t.columns) {
+		return fmt.Errorf("Column index out of range: %d", col)
+	}
+	if rows < 1 || cols < 1 {
+		return fmt.Errorf("Value must be greater than 0")
+	}
+	if row+rows > t.NumRows() || col+cols > len(t.columns) {
+		return fmt.Errorf("Span doesn't fit in the table: %d %d", rows, cols)
+	}
+
+	for idx, s := range t.spans {
+		if idx == (cellIndex{row, col}) {
+			continue
+		}
+		if idx.row < row+rows && row < idx.row+s.rows && idx.col < col+cols && col < idx.col+s.cols {
+			return fmt.Errorf("Span overlaps another span at %d %d", idx.row, idx.col)
+		}
+	}
+
+	if rows == 1 && cols == 1 {
+		delete(t.spans, cellIndex{row, col})
+	} else {
+		if t.spans == nil {
+			t.spans = make(map[cellIndex]cellSpan)
+		}
+		t.spans[cellIndex{row, col}] = cellSpan{rows, cols}
+	}
+	t.measureContent()
+	return nil
+}
+
+// SetHeaderSpan merges the header of the column with index col with headers
+// of cols columns to the right of it. One column removes the previous span.
+func (t *Table) SetHeaderSpan(col, cols int) error {
+	if len(t.headers) == 0 {
+		return fmt.Errorf("Table has no headers")
+	}
+	if t.headerTree != nil {
+		return fmt.Errorf("Header spans can't be used with header tree")
+	}
+	if col < 0 || col >= len(t.columns) {
+		return fmt.Errorf("Column index out of range: %d", col)
+	}
+	if cols < 1 {
+		return fmt.Errorf("Value must be greater than 0")
+	}
+	if col+cols > len(t.columns) {
+		return fmt.Errorf("Span doesn't fit in the table: %d %d", 1, cols)
+	}
+	for c, n := range t.headerSpans {
+		if c != col && c < col+cols && col < c+n {
+			return fmt.Errorf("Span overlaps another span at %d %d", -1, c)
+		}
+	}
+
+	if cols == 1 {
+		delete(t.headerSpans, col)
+	} else {
+		if t.headerSpans == nil {
+			t.headerSpans = make(map[int]int)
+		}
+		t.headerSpans[col] = cols
+	}
+	t.measureContent()
+	return nil
+}
+
+// insertSpanRow moves spans below the row inserted at idx, spans around it
+// grow over the new row.
+func (t *Table) insertSpanRow(idx int) {
+	if len(t.spans) == 0 {
+		return
+	}
+	spans := make(map[cellIndex]cellSpan, len(t.spans))
+	for k, s := range t.spans {
+		switch {
+		case k.row >= idx:
+			k.row++
+		case idx < k.row+s.rows:
+			s.rows++
+		}
+		spans[k] = s
+	}
+	t.spans = spans
+}
+
+// removeSpanRow moves spans below the row removed at idx and shrinks spans
+// over it.
+func (t *Table) removeSpanRow(idx int) {
+	if len(t.spans) == 0 {
+		return
+	}
+	spans := make(map[cellIndex]cellSpan, len(t.spans))
+	for k, s := range t.spans {
+		switch {
+		case k.row > idx:
+			k.row--
+		case idx < k.row+s.rows:
+			s.rows--
+		}
+		if s.rows > 1 || (s.rows == 1 && s.cols > 1) {
+			spans[k] = s
+		}
+	}
+	t.spans = spans
+}
+
+// reorderSpans moves spans to rows[row]. A span over several rows keeps them
+// only if they stay together in the same order, otherwise it's cut down to
+// the anchor row.
+func (t *Table) reorderSpans(rows map[int]int) {
+	if len(t.spans) == 0 {
+		return
+	}
+	spans := make(map[cellIndex]cellSpan, len(t.spans))
+	for k, s := range t.spans {
+		row := rows[k.row]
+		for i := 1; i < s.rows; i++ {
+			if rows[k.row+i] != row+i {
+				s.rows = 1
+				break
+			}
+		}
+		if s.rows > 1 || s.cols > 1 {
+			spans[cellIndex{row, k.col}] = s
+		}
+	}
+	t.spans = spans
+}
+
+func (t *Table) hasSpans() bool {
+	return len(t.spans) > 0 || len(t.headerSpans) > 0 || t.headerTree != nil
+}
+
+// spanOwners returns the anchor cell of every body cell.
+func (t *Table) spanOwners() [][]cellIndex {
+	body := make([][]cellIndex, t.NumRows())
+	for i := range body {
+		body[i] = make([]cellIndex, len(t.columns))
+		for c := range body[i] {
+			body[i][c] = cellIndex{i, c}
+		}
+	}
+	for idx, s := range t.spans {
+		for i := idx.row; i < idx.row+s.rows; i++ {
+			for c := idx.col; c < idx.col+s.cols; c++ {
+				body[i][c] = idx
+			}
+		}
+	}
+	return body
+}
+
+// covered reports whether the body cell is merged into a cell of another
+// span, header cells have row -1.
+func (t *Table) covered(row, col int) bool {
+	if row < 0 {
+		for c, n := range t.headerSpans {
+			if c < col && col < c+n {
+				return true
+			}
+		}
+		return false
+	}
+	for idx, s := range t.spans {
+		if idx != (cellIndex{row, col}) && idx.row <= row && row < idx.row+s.rows && idx.col <= col && col < idx.col+s.cols {
+			return true
+		}
+	}
+	return false
+}
+
+// spanWidths returns widths of values of cells spanning several columns.
+func (t *Table) spanWidths() []spanWidth {
+	var widths []spanWidth
+	_, cells := t.headerGrid()
+	for _, c := range cells {
+		if c.cols > 1 || !c.leaf {
+			widths = append(widths, spanWidth{c.col, c.cols, blockWidth(c.name)})
+		}
+	}
+	for idx, s := range t.spans {
+		if s.cols > 1 {
+			widths = append(widths, spanWidth{idx.col, s.cols, blockWidth(t.columns[idx.col][idx.row])})
+		}
+	}
+	// Maps are iterated in random order, keep the distribution of widths
+	// stable.
+	for i := 1; i < len(widths); i++ {
+		for j := i; j > 0 && spanWidthLess(widths[j], widths[j-1]); j-- {
+			widths[j], widths[j-1] = widths[j-1], widths[j]
+		}
+	}
+	return widths
+}
+
+func spanWidthLess(a, b spanWidth) bool {
+	if a.col != b.col {
+		return a.col < b.col
+	}
+	if a.cols != b.cols {
+		return a.cols < b.cols
+	}
+	return a.width < b.width
+}
+
+// spanLength returns the full length of cols columns starting from col,
+// including inner borders between them.
+func (r *renderer) spanLength(col, cols int) int {
+	length := 0
+	for k := col; k < col+cols; k++ {
+		length += r.getLengthByIndex(k)
+	}
+	if r.t.Options.InnerBorder() {
+		length += cols - 1
+	}
+	return length
+}
+
+// segment is a cell of a drawn row, possibly spanning several columns.
+type segment struct {
+	col, cols int
+	lines     []string
+	lineAlign align
+	style     Style
+	// top draws lines from the top of the row instead of centering them.
+	top bool
+}
+
+func (r *renderer) drawSegments(border BorderVertical, segments []segment, rowHeight int) {
+	for n := 0; n < rowHeight; n++ {
+		if r.t.Options.OuterBorder() {
+			r.writeBorder(string(border.Left))
+		}
+		for _, s := range segments {
+			if s.col > 0 && r.t.Options.InnerBorder() {
+				r.writeBorder(string(border.Middle))
+			}
+			height := rowHeight
+			if s.top {
+				height = len(s.lines)
+			}
+			r.drawValueMultiLine(s.lineAlign, r.l.paddings[s.col], r.spanLength(s.col, s.cols), s.style, height, n, s.lines)
+		}
+		if r.t.Options.OuterBorder() {
+			r.writeBorder(string(border.Right))
+		}
+		r.newLine()
+	}
+}
+
+// spanLines returns lines of the value of a cell spanning cols columns.
+func (r *renderer) spanLines(col, cols int, v string) []string {
+	width := r.spanLength(col, cols) - 2*r.l.paddings[col]
+	return r.cellLinesWidth(col, v, width)
+}
+
+// headerSegments returns cells of the header row with index level. Leaves
+// spanning several rows are drawn in the last one.
+func (r *renderer) headerSegments(owners [][]cellIndex, cells map[cellIndex]headerCell, level int) ([]segment, int) {
+	var segments []segment
+	rowHeight := 1
+	lineAlign := r.t.Options.HeaderAlign()
+	if lineAlign == DECIMAL {
+		lineAlign = RIGHT
+	}
+	for c := 0; c < len(r.t.columns); {
+		cell := cells[owners[level][c]]
+		var lines []string
+		if !cell.leaf || level == len(owners)-1 {
+			lines = r.spanLines(c, cell.cols, cell.name)
+		}
+		if len(lines) > rowHeight {
+			rowHeight = len(lines)
+		}
+		segments = append(segments, segment{c, cell.cols, lines, lineAlign, r.t.Options.HeaderStyle(), false})
+		c += cell.cols
+	}
+	return segments, rowHeight
+}
+
+// spanRowHeights returns heights of body rows. A cell spanning several rows
+// extends the last of them if its lines don't fit.
+func (r *renderer) spanRowHeights() []int {
+	heights := make([]int, r.t.NumRows())
+	for i := range heights {
+		heights[i] = 1
+		for c := range r.t.columns {
+			if r.t.covered(i, c) {
+				continue
+			}
+			s := r.t.span(i, c)
+			if s.rows > 1 {
+				continue
+			}
+			if n := len(r.spanLines(c, s.cols, r.t.columns[c][i])); n > heights[i] {
+				heights[i] = n
+			}
+		}
+	}
+	for idx, s := range r.t.spans {
+		if s.rows == 1 {
+			continue
+		}
+		n := len(r.spanLines(idx.col, s.cols, r.t.columns[idx.col][idx.row]))
+		for i := idx.row; i < idx.row+s.rows-1; i++ {
+			n -= heights[i]
+		}
+		if last := idx.row + s.rows - 1; n > heights[last] {
+			heights[last] = n
+		}
+	}
+	return heights
+}
+
+func (t *Table) span(row, col int) cellSpan {
+	if s, ok := t.spans[cellIndex{row, col}]; ok {
+		return s
+	}
+	return cellSpan{1, 1}
+}
+
+// bodySegments returns cells of the body row with index i. Cells spanning
+// several rows are drawn from their first row, or from the row with index from
+// if they started on a previous page.
+func (r *renderer) bodySegments(i, from int, owners [][]cellIndex, heights []int) []segment {
+	var segments []segment
+	for c := 0; c < len(r.t.columns); {
+		owner := owners[i][c]
+		s := r.t.span(owner.row, owner.col)
+		col := r.t.ColumnOptions(c)
+		lines := r.spanLines(c, s.cols, r.t.columns[c][owner.row])
+		lineAlign := col.CellAlign()
+		if lineAlign == DECIMAL && s.cols > 1 {
+			lineAlign = RIGHT
+		} else if lineAlign == DECIMAL {
+			lines = r.alignDecimal(c, lines)
+		}
+
+		seg := segment{c, s.cols, lines, lineAlign, r.t.Options.bodyStyle(owner.row, owner.col), false}
+		if s.rows > 1 {
+			start := owner.row
+			if start < from {
+				start = from
+			}
+			offset := 0
+			for k := start; k < i; k++ {
+				offset += heights[k]
+			}
+			end := offset + heights[i]
+			if offset > len(lines) {
+				offset = len(lines)
+			}
+			if end > len(lines) {
+				end = len(lines)
+			}
+			seg.lines, seg.top = lines[offset:end], true
+		}
+		segments = append(segments, seg)
+		c += s.cols
+	}
+	return segments
+}
+
+// drawSpannedRows draws body rows like drawRows for tables with spans.
+func (r *renderer) drawSpannedRows(border BorderStyle, from, to int) {
+	owners := r.t.spanOwners()
+	heights := r.spanRowHeights()
+	if len(r.t.headers) == 0 && r.t.Options.OuterBorder() && from < to {
+		r.drawSpanLine(border.Body, border.Top, nil, owners[from])
+	}
+
+	for i := from; i < to; i++ {
+		r.drawSegments(border.Body, r.bodySegments(i, from, owners, heights), heights[i])
+
+		switch {
+		case i == r.t.NumRows()-1 && r.l.footer != nil:
+		case i == to-1:
+			if r.t.Options.OuterBorder() {
+				r.drawSpanLine(border.Body, border.Bottom, owners[i], nil)
+			}
+		case r.t.Options.InnerBorder():
+			r.drawSpanLine(border.Body, border.Row, owners[i], owners[i+1])
+		}
+	}
+	if to == r.t.NumRows() && r.l.footer != nil {
+		var above []cellIndex
+		if to > 0 {
+			above = owners[to-1]
+		}
+		r.drawFooterBelow(border, above)
+	}
+}
+
+// drawSpannedHeader draws headers like drawHeader for tables with spans or a
+// header tree. Rows of a header tree are separated with HeaderBottom.
+func (r *renderer) drawSpannedHeader(border BorderStyle) {
+	owners, cells := r.t.headerGrid()
+	if r.t.Options.OuterBorder() {
+		r.drawSpanLine(border.Header, border.HeaderTop, nil, owners[0])
+	}
+	for l := range owners {
+		segments, rowHeight := r.headerSegments(owners, cells, l)
+		r.drawSegments(border.Header, segments, rowHeight)
+		if l < len(owners)-1 {
+			r.drawSpanLine(border.Header, border.HeaderBottom, owners[l], owners[l+1])
+		}
+	}
+
+	var below []cellIndex
+	if body := r.t.spanOwners(); len(body) > 0 {
+		below = body[0]
+	}
+	r.drawSpanLine(border.Header, border.HeaderBottom, owners[len(owners)-1], below)
+}
+
+// drawSpanLine draws a horizontal line between rows with given owners of
+// cells, nil owners mean there is no row. The line is left out under cells
+// spanning both rows, where vertical borders are drawn instead. Junctions are
+// picked by borders they connect.
+func (r *renderer) drawSpanLine(vertical BorderVertical, border BorderLine, above, below []cellIndex) {
+	if border.Line == 0 {
+		return
+	}
+	// Junctions of other lines are only borrowed by lines drawn with the
+	// same symbols as Row, e.g. box-drawing ones.
+	style := r.t.Options.BorderStyle()
+	if border.Cross != style.Row.Cross {
+		style.Top = BorderLine{border.Left, border.Cross, border.Right, border.Line}
+		style.Bottom = style.Top
+	}
+
+	drawn := func(c int) bool {
+		return above == nil || below == nil || above[c] != below[c]
+	}
+	junction := func(b int) rune {
+		left, right := drawn(b-1), drawn(b)
+		up := above != nil && above[b-1] != above[b]
+		down := below != nil && below[b-1] != below[b]
+		switch {
+		case left && right:
+			switch {
+			case up && down, down && above == nil, up && below == nil:
+				return border.Cross
+			case down:
+				return style.Top.Cross
+			case up:
+				return style.Bottom.Cross
+			}
+			return border.Line
+		case left:
+			switch {
+			case up && down:
+				return border.Right
+			case up:
+				return style.Bottom.Right
+			case down:
+				return style.Top.Right
+			}
+			return border.Line
+		case right:
+			switch {
+			case up && down:
+				return border.Left
+			case up:
+				return style.Bottom.Left
+			case down:
+				return style.Top.Left
+			}
+			return border.Line
+		case up || down:
+			return vertical.Middle
+		}
+		return ' '
+	}
+
+	var line []rune
+	last := len(r.t.columns) - 1
+	if r.t.Options.OuterBorder() {
+		if drawn(0) {
+			line = append(line, border.Left)
+		} else {
+			line = append(line, vertical.Left)
+		}
+	}
+	for c := range r.t.columns {
+		if c > 0 && r.t.Options.InnerBorder() {
+			line = append(line, junction(c))
+		}
+		fill := border.Line
+		if !drawn(c) {
+			fill = ' '
+		}
+		for j := 0; j < r.getLengthByIndex(c); j++ {
+			line = append(line, fill)
+		}
+	}
+	if r.t.Options.OuterBorder() {
+		if drawn(last) {
+			line = append(line, border.Right)
+		} else {
+			line = append(line, vertical.Right)
+		}
+	}
+	r.writeBorder(string(line))
+	r.newLine()
+}
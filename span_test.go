@@ -0,0 +1,168 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpan(t *testing.T) {
+	t.Run("Row and column spans", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"region", "jan", "feb", "mar"},
+			[][]interface{}{{"North", 1, 2, 3}, {"North", 4, 5, 6}, {"South", 7, 8, 9}, {"Total for the whole year", "", "", ""}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		assert.NoError(t, tab.SetSpan(0, 0, 2, 1))
+		assert.NoError(t, tab.SetSpan(3, 0, 1, 4))
+		assert.NoError(t, tab.SetHeaderSpan(1, 2))
+
+		want := `#=========#=========#======#
+# region  #   jan   # mar  #
+#=========#====#====#======#
+|  North  | 1  | 2  |  3   |
+|         +----+----+------+
+|         | 4  | 5  |  6   |
++---------+----+----+------+
+|  South  | 7  | 8  |  9   |
++---------+----+----+------+
+| Total for the whole year |
++--------------------------+
+`
+		assert.Equal(t, want, tab.Draw())
+
+		tab.Options.SetBorderStyle(BorderLight)
+		want = `┌─────────┬─────────┬──────┐
+│ region  │   jan   │ mar  │
+├─────────┼────┬────┼──────┤
+│  North  │ 1  │ 2  │  3   │
+│         ├────┼────┼──────┤
+│         │ 4  │ 5  │  6   │
+├─────────┼────┼────┼──────┤
+│  South  │ 7  │ 8  │  9   │
+├─────────┴────┴────┴──────┤
+│ Total for the whole year │
+└──────────────────────────┘
+`
+		assert.Equal(t, want, tab.Draw())
+
+		sel, _ := tab.Select(1, 2, 3)
+		assert.Equal(t, map[int]int{0: 2}, sel.headerSpans)
+		sel, _ = tab.Select(0, 1, 3)
+		assert.Nil(t, sel.headerSpans)
+	})
+
+	t.Run("Block span", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"region", "jan", "feb", "mar"},
+			[][]interface{}{{"North", 1, 2, 3}, {"North", 4, 5, 6}, {"South", 7, 8, 9}, {"Total for the whole year", "", "", ""}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetBorderStyle(BorderLight)
+		tab.RemoveRow(3)
+		assert.NoError(t, tab.SetSpan(1, 1, 2, 2))
+
+		want := `┌────────┬─────┬─────┬─────┐
+│ region │ jan │ feb │ mar │
+├────────┼─────┼─────┼─────┤
+│ North  │  1  │  2  │  3  │
+├────────┼─────┴─────┼─────┤
+│ North  │     4     │  6  │
+├────────┤           ├─────┤
+│ South  │           │  9  │
+└────────┴───────────┴─────┘
+`
+		assert.Equal(t, want, tab.Draw())
+
+		assert.NoError(t, tab.SetSpan(1, 1, 1, 1))
+		assert.Contains(t, tab.Draw(), "│ North  │  4  │  5  │  6  │\n")
+	})
+
+	t.Run("Remove row", func(t *testing.T) {
+		tab, _ := NewTableFromRows("", []string{"a", "b"}, [][]interface{}{{1, "x"}, {2, "y"}, {3, "z"}})
+		tab.Options.SetCellFitContent(true)
+		assert.NoError(t, tab.SetSpan(1, 0, 2, 1))
+		assert.NoError(t, tab.RemoveRow(2))
+		assert.Empty(t, tab.spans)
+
+		want := `#=====#=====#
+#  a  #  b  #
+#=====#=====#
+|  1  |  x  |
++-----+-----+
+|  2  |  y  |
++-----+-----+
+`
+		assert.Equal(t, want, tab.Draw())
+
+		tab.AppendRow(3, "z")
+		assert.NoError(t, tab.SetSpan(1, 0, 2, 1))
+		assert.NoError(t, tab.RemoveRow(0))
+		assert.Equal(t, map[cellIndex]cellSpan{{0, 0}: {2, 1}}, tab.spans)
+	})
+
+	t.Run("Insert row", func(t *testing.T) {
+		tab, _ := NewTableFromRows("", []string{"a", "b"}, [][]interface{}{{1, "x"}, {2, "y"}})
+		tab.Options.SetCellFitContent(true)
+		assert.NoError(t, tab.SetSpan(0, 0, 2, 1))
+		assert.NoError(t, tab.InsertRow(0, 0, "w"))
+		assert.Equal(t, map[cellIndex]cellSpan{{1, 0}: {2, 1}}, tab.spans)
+
+		want := `#=====#=====#
+#  a  #  b  #
+#=====#=====#
+|  0  |  w  |
++-----+-----+
+|  1  |  x  |
+|     +-----+
+|     |  y  |
++-----+-----+
+`
+		assert.Equal(t, want, tab.Draw())
+
+		assert.NoError(t, tab.InsertRow(2, 9, "v"))
+		assert.Equal(t, map[cellIndex]cellSpan{{1, 0}: {3, 1}}, tab.spans)
+	})
+
+	t.Run("Sort rows", func(t *testing.T) {
+		tab, _ := NewTableFromRows("", []string{"a", "b", "c"}, [][]interface{}{{3, "x", "x"}, {1, "y", ""}, {2, "z", ""}})
+		assert.NoError(t, tab.SetSpan(0, 1, 1, 2))
+		assert.NoError(t, tab.SetSpan(1, 0, 2, 1))
+		assert.NoError(t, tab.SortBy(0, false))
+		assert.Equal(t, map[cellIndex]cellSpan{{2, 1}: {1, 2}, {0, 0}: {2, 1}}, tab.spans)
+
+		assert.NoError(t, tab.SortBy(0, true))
+		assert.Equal(t, map[cellIndex]cellSpan{{0, 1}: {1, 2}}, tab.spans)
+		assert.NotPanics(t, func() { tab.Draw() })
+	})
+
+	t.Run("Invalid spans", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"",
+			[]string{"region", "jan", "feb", "mar"},
+			[][]interface{}{{"North", 1, 2, 3}, {"North", 4, 5, 6}, {"South", 7, 8, 9}, {"Total for the whole year", "", "", ""}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		assert.Error(t, tab.SetSpan(4, 0, 1, 1))
+		assert.Error(t, tab.SetSpan(0, 4, 1, 1))
+		assert.Error(t, tab.SetSpan(0, 0, 0, 1))
+		assert.Error(t, tab.SetSpan(3, 0, 2, 1))
+		assert.Error(t, tab.SetHeaderSpan(2, 3))
+
+		tab.SetSpan(0, 0, 2, 2)
+		err := tab.SetSpan(1, 1, 2, 1)
+		if assert.Error(t, err) {
+			assert.Equal(t, "Span overlaps another span at 0 0", err.Error())
+		}
+		tab.SetHeaderSpan(0, 2)
+		assert.Error(t, tab.SetHeaderSpan(1, 2))
+
+		noHeaders, _ := NewTable("", nil, [][]interface{}{{1}})
+		assert.Error(t, noHeaders.SetHeaderSpan(0, 1))
+	})
+}
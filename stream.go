@@ -0,0 +1,149 @@
+package tymbol
+
+import (
+	"fmt"
+	"io"
+)
+
+// StreamTable writes rows to w as soon as they are pushed. Column lengths are
+// fixed when the header is written, values that don't fit are wrapped or
+// truncated according to Options.
+type StreamTable struct {
+	Title   string
+	Options Options
+
+	table  Table
+	r      renderer
+	widths []int
+	rows   int
+
+	started bool
+	closed  bool
+}
+
+// NewStreamTable creates a stream table with given content length of every
+// column. Headers may be nil.
+func NewStreamTable(w io.Writer, title string, headers []string, widths []int) (*StreamTable, error) {
+	if len(widths) == 0 {
+		return nil, fmt.Errorf("Widths cannot be empty!")
+	}
+	if len(headers) > 0 && len(headers) != len(widths) {
+		return nil, fmt.Errorf("Number of headers and columns don't match: %d %d", len(headers), len(widths))
+	}
+	for i := range widths {
+		if widths[i] <= 0 {
+			return nil, fmt.Errorf("Width must be greater than 0. Column index: %d", i)
+		}
+	}
+
+	s := &StreamTable{
+		Title:   title,
+		Options: defaultOptions(),
+		widths:  widths,
+		table: Table{
+			headers:      headers,
+			columns:      make([][]string, len(widths)),
+			maxColLength: widths,
+		},
+	}
+	s.r = renderer{t: &s.table, w: w}
+	return s, nil
+}
+
+// NewStreamTableFromSample creates a stream table sized to fit headers and
+// sample rows. The sample is only measured, it isn't written.
+func NewStreamTableFromSample(w io.Writer, title string, headers []string, sample [][]interface{}) (*StreamTable, error) {
+	t, err := NewTableFromRows(title, headers, sample)
+	if err != nil {
+		return nil, err
+	}
+	widths := make([]int, len(t.maxColLength))
+	for i := range widths {
+		widths[i] = t.maxColLength[i]
+		if widths[i] == 0 {
+			widths[i] = 1
+		}
+	}
+	return NewStreamTable(w, title, headers, widths)
+}
+
+// WriteHeader writes the title and headers. It's called by Push and Close if
+// it wasn't called before, Options changed afterwards don't affect lengths
+// of columns.
+func (s *StreamTable) WriteHeader() error {
+	if s.started {
+		return s.r.err
+	}
+	s.started = true
+
+	s.table.Title = s.Title
+	s.table.Options = s.Options
+	s.table.Options.columnOptions = make(map[int]ColumnOptions, len(s.widths))
+	for i, c := range s.Options.columnOptions {
+		s.table.Options.columnOptions[i] = c
+	}
+	for i := range s.widths {
+		c := s.table.Options.columnOptions[i]
+		if c.cellLength == 0 && !s.hasHeaderLength(i) {
+			c.cellLength = s.widths[i]
+		}
+		s.table.Options.columnOptions[i] = c
+	}
+	s.r.l = s.table.layout()
+
+	border := s.table.Options.BorderStyle()
+	s.r.drawTitle()
+	s.r.drawHeader(border)
+	if len(s.table.headers) == 0 && s.table.Options.OuterBorder() {
+		s.r.drawLine(border.Top)
+	}
+	return s.r.err
+}
+
+func (s *StreamTable) hasHeaderLength(col int) bool {
+	if col >= len(s.table.headers) {
+		return false
+	}
+	return s.Options.headerColumnOptions[s.table.headers[col]].cellLength > 0
+}
+
+// Push writes a single row.
+func (s *StreamTable) Push(values ...interface{}) error {
+	if s.closed {
+		return fmt.Errorf("Stream table is closed")
+	}
+	if len(values) != len(s.widths) {
+		return fmt.Errorf("Number of values and columns don't match: %d %d", len(values), len(s.widths))
+	}
+	if err := s.WriteHeader(); err != nil {
+		return err
+	}
+
+	row := make([]string, len(values))
+	for i := range values {
+		row[i] = fmt.Sprintf("%v", values[i])
+	}
+	border := s.table.Options.BorderStyle()
+	if s.rows > 0 && s.table.Options.InnerBorder() {
+		s.r.drawLine(border.Row)
+	}
+	s.r.drawBodyRow(border, s.rows, row)
+	s.rows++
+	return s.r.err
+}
+
+// Close writes the closing border. It doesn't close the underlying writer.
+func (s *StreamTable) Close() error {
+	if s.closed {
+		return nil
+	}
+	if err := s.WriteHeader(); err != nil {
+		return err
+	}
+	s.closed = true
+
+	if s.table.Options.OuterBorder() {
+		s.r.drawLine(s.table.Options.BorderStyle().Bottom)
+	}
+	return s.r.err
+}
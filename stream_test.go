@@ -0,0 +1,65 @@
+package tymbol
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamTable(t *testing.T) {
+	t.Run("Rows are written on push", func(t *testing.T) {
+		var buf bytes.Buffer
+		s, err := NewStreamTable(&buf, "", []string{"id", "message"}, []int{2, 8})
+		assert.NoError(t, err)
+		s.Options.SetBorderStyle(BorderLight)
+		s.Options.SetCellPadding(1)
+
+		assert.NoError(t, s.WriteHeader())
+		want := "┌────┬──────────┐\n" +
+			"│ id │ message  │\n" +
+			"├────┼──────────┤\n"
+		assert.Equal(t, want, buf.String())
+
+		assert.NoError(t, s.Push(1, "started"))
+		assert.NoError(t, s.Push(2, "connection lost"))
+		assert.NoError(t, s.Close())
+		want += "│ 1  │ started  │\n" +
+			"├────┼──────────┤\n" +
+			"│ 2  │ connecti │\n" +
+			"│    │ on lost  │\n" +
+			"└────┴──────────┘\n"
+		assert.Equal(t, want, buf.String())
+
+		assert.Error(t, s.Push(3, "late"))
+	})
+
+	t.Run("Sized from sample with truncation", func(t *testing.T) {
+		var buf bytes.Buffer
+		s, err := NewStreamTableFromSample(&buf, "", nil, [][]interface{}{{"GET", "/index"}})
+		assert.NoError(t, err)
+		s.Options.SetCellPadding(0)
+		s.Options.SetOverflow(OVERFLOW_TRUNCATE)
+		s.Options.SetCellAlign(LEFT)
+
+		s.Push("POST", "/users/login")
+		s.Close()
+		want := "+---+------+\n" +
+			"|PO…|/user…|\n" +
+			"+---+------+\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := NewStreamTable(&buf, "", nil, nil)
+		assert.Error(t, err)
+		_, err = NewStreamTable(&buf, "", []string{"a"}, []int{1, 2})
+		assert.Error(t, err)
+		_, err = NewStreamTable(&buf, "", nil, []int{0})
+		assert.Error(t, err)
+
+		s, _ := NewStreamTable(&buf, "", nil, []int{1})
+		assert.Error(t, s.Push(1, 2))
+	})
+}
@@ -0,0 +1,127 @@
+package tymbol
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const structTag = "tymbol"
+
+type structField struct {
+	index  []int
+	header string
+	align  align
+}
+
+// NewTableFromStructs creates a table with a column for every exported field
+// of T, which must be a struct or a pointer to a struct. Columns are
+// configured with the "tymbol" tag:
+//
+//	Name  string  `tymbol:"Full name"`
+//	Score float64 `tymbol:",align=right"`
+//	Token string  `tymbol:",omit"`
+func NewTableFromStructs[T any](title string, rows []T) (Table, error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return Table{}, fmt.Errorf("Expected slice of structs, got %s", typ)
+	}
+
+	fields, err := parseStructFields(typ)
+	if err != nil {
+		return Table{}, err
+	}
+	if len(fields) == 0 {
+		return Table{}, fmt.Errorf("Columns cannot be empty!")
+	}
+
+	headers := make([]string, len(fields))
+	columns := make([][]interface{}, len(fields))
+	for i, f := range fields {
+		headers[i] = f.header
+		columns[i] = make([]interface{}, len(rows))
+	}
+
+	for j := range rows {
+		v := reflect.ValueOf(&rows[j]).Elem()
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				for i := range fields {
+					columns[i][j] = ""
+				}
+				continue
+			}
+			v = v.Elem()
+		}
+		for i, f := range fields {
+			fv, err := v.FieldByIndexErr(f.index)
+			if err != nil {
+				columns[i][j] = ""
+				continue
+			}
+			columns[i][j] = fv.Interface()
+		}
+	}
+
+	t, err := NewTable(title, headers, columns)
+	if err != nil {
+		return Table{}, err
+	}
+	for i, f := range fields {
+		if f.align == "" {
+			continue
+		}
+		var c ColumnOptions
+		if err := c.SetCellAlign(f.align); err != nil {
+			return Table{}, err
+		}
+		if err := t.Options.SetColumnOptions(i, c); err != nil {
+			return Table{}, err
+		}
+	}
+	return t, nil
+}
+
+func parseStructFields(typ reflect.Type) ([]structField, error) {
+	var fields []structField
+	for _, f := range reflect.VisibleFields(typ) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+
+		field := structField{index: f.Index, header: f.Name}
+		tag, ok := f.Tag.Lookup(structTag)
+		if tag == "-" {
+			continue
+		}
+		if ok {
+			parts := strings.Split(tag, ",")
+			if parts[0] != "" {
+				field.header = parts[0]
+			}
+			omit := false
+			for _, opt := range parts[1:] {
+				key, value, _ := strings.Cut(strings.TrimSpace(opt), "=")
+				switch key {
+				case "omit":
+					omit = true
+				case "align":
+					if !checkAlignOption(value) {
+						return nil, fmt.Errorf("Unknown align option in field %s. Expected %v, got %s", f.Name, availableAligns, value)
+					}
+					field.align = value
+				default:
+					return nil, fmt.Errorf("Unknown tag option in field %s: %s", f.Name, opt)
+				}
+			}
+			if omit {
+				continue
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
@@ -0,0 +1,71 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type base struct {
+	ID int
+}
+
+type player struct {
+	base
+	Name   string
+	Score  float64 `tymbol:"Points,align=right"`
+	Token  string  `tymbol:",omit"`
+	Secret string  `tymbol:"-"`
+	rank   int
+}
+
+func TestNewTableFromStructs(t *testing.T) {
+	t.Run("Successful creation", func(t *testing.T) {
+		tab, err := NewTableFromStructs("Players", []player{
+			{base: base{1}, Name: "Bob", Score: 10, Token: "x"},
+			{base: base{2}, Name: "Alice", Score: 9.88, rank: 1},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ID", "Name", "Points"}, tab.headers)
+		assert.Equal(t, [][]string{{"1", "2"}, {"Bob", "Alice"}, {"10", "9.88"}}, tab.columns)
+		points := tab.ColumnOptions(2)
+		assert.Equal(t, RIGHT, points.CellAlign())
+		name := tab.ColumnOptions(1)
+		assert.Equal(t, CENTER, name.CellAlign())
+
+		tab.Options.SetCellFitContent(true)
+		want := `           Players           
+#======#=========#==========#
+#  ID  #  Name   #  Points  #
+#======#=========#==========#
+|  1   |   Bob   |      10  |
++------+---------+----------+
+|  2   |  Alice  |    9.88  |
++------+---------+----------+
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Pointers to structs", func(t *testing.T) {
+		tab, err := NewTableFromStructs("", []*player{{Name: "Bob"}, nil})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"0", ""}, {"Bob", ""}, {"0", ""}}, tab.columns)
+	})
+
+	t.Run("Not a struct", func(t *testing.T) {
+		_, err := NewTableFromStructs("", []int{1, 2})
+		if assert.Error(t, err) {
+			assert.Equal(t, "Expected slice of structs, got int", err.Error())
+		}
+	})
+
+	t.Run("Unknown align", func(t *testing.T) {
+		type row struct {
+			A int `tymbol:",align=top"`
+		}
+		_, err := NewTableFromStructs("", []row{})
+		if assert.Error(t, err) {
+			assert.Equal(t, "Unknown align option in field A. Expected [left right center decimal], got top", err.Error())
+		}
+	})
+}
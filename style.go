@@ -0,0 +1,145 @@
+package tymbol
+
+import "strings"
+
+// Style is a set of SGR parameters, e.g. "1;31" for bold red text.
+type Style string
+
+const (
+	Bold      Style = "1"
+	Dim       Style = "2"
+	Italic    Style = "3"
+	Underline Style = "4"
+	Reverse   Style = "7"
+
+	FgBlack   Style = "30"
+	FgRed     Style = "31"
+	FgGreen   Style = "32"
+	FgYellow  Style = "33"
+	FgBlue    Style = "34"
+	FgMagenta Style = "35"
+	FgCyan    Style = "36"
+	FgWhite   Style = "37"
+
+	BgBlack   Style = "40"
+	BgRed     Style = "41"
+	BgGreen   Style = "42"
+	BgYellow  Style = "43"
+	BgBlue    Style = "44"
+	BgMagenta Style = "45"
+	BgCyan    Style = "46"
+	BgWhite   Style = "47"
+)
+
+const (
+	escape   = "\x1b"
+	sgrReset = "\x1b[0m"
+)
+
+// NewStyle combines several styles into one.
+func NewStyle(styles ...Style) Style {
+	parts := make([]string, 0, len(styles))
+	for _, s := range styles {
+		if s != "" {
+			parts = append(parts, string(s))
+		}
+	}
+	return Style(strings.Join(parts, ";"))
+}
+
+// Apply wraps v in the style. Resets inside v end only their own styles,
+// the outer style is opened again after each of them.
+func (s Style) Apply(v string) string {
+	if s == "" || v == "" {
+		return v
+	}
+	open := escape + "[" + string(s) + "m"
+	if !strings.Contains(v, escape) {
+		return open + v + sgrReset
+	}
+
+	var b strings.Builder
+	b.WriteString(open)
+	for v != "" {
+		n := escapeLength(v)
+		if n == 0 {
+			i := strings.Index(v[1:], escape)
+			if i < 0 {
+				b.WriteString(v)
+				break
+			}
+			b.WriteString(v[:i+1])
+			v = v[i+1:]
+			continue
+		}
+		b.WriteString(v[:n])
+		if isSGRReset(v[:n]) {
+			b.WriteString(open)
+		}
+		v = v[n:]
+	}
+	b.WriteString(sgrReset)
+	return b.String()
+}
+
+type cellIndex struct {
+	row, col int
+}
+
+// escapeLength returns the byte length of the escape sequence s starts with,
+// or 0 if s doesn't start with one.
+func escapeLength(s string) int {
+	if len(s) < 2 || s[0] != escape[0] {
+		return 0
+	}
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']':
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == escape[0] && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	}
+	return 2
+}
+
+func isSGR(seq string) bool {
+	return strings.HasPrefix(seq, escape+"[") && strings.HasSuffix(seq, "m")
+}
+
+func isSGRReset(seq string) bool {
+	return seq == sgrReset || seq == escape+"[m"
+}
+
+// stripEscapes removes escape sequences from s.
+func stripEscapes(s string) string {
+	if !strings.Contains(s, escape) {
+		return s
+	}
+	var b strings.Builder
+	for s != "" {
+		if n := escapeLength(s); n > 0 {
+			s = s[n:]
+			continue
+		}
+		i := strings.Index(s[1:], escape)
+		if i < 0 {
+			b.WriteString(s)
+			break
+		}
+		b.WriteString(s[:i+1])
+		s = s[i+1:]
+	}
+	return b.String()
+}
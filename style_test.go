@@ -0,0 +1,88 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStyle(t *testing.T) {
+	assert.Equal(t, Style("1;31"), NewStyle(Bold, "", FgRed))
+	assert.Equal(t, "\x1b[1;31mtest\x1b[0m", NewStyle(Bold, FgRed).Apply("test"))
+	assert.Equal(t, "test", Style("").Apply("test"))
+	assert.Equal(t, "\x1b[31m\x1b[1mbold\x1b[0m\x1b[31m tail\x1b[0m", FgRed.Apply("\x1b[1mbold\x1b[0m tail"))
+	assert.Equal(t, "\x1b[31ma\x1b[m\x1b[31mb\x1b[0m", FgRed.Apply("a\x1b[mb"))
+	assert.Equal(t, 4, stringWidth("\x1b[31mtest\x1b[0m"))
+	assert.Equal(t, 4, stringWidth("\x1b]8;;http://example.com\x1b\\test\x1b]8;;\x1b\\"))
+}
+
+func TestSplitStyledValue(t *testing.T) {
+	got := splitByWidth("\x1b[31mtesttest\x1b[0mte", 4)
+	want := []string{"\x1b[31mtest\x1b[0m", "\x1b[31mtest\x1b[0m", "te"}
+	assert.Equal(t, want, got)
+
+	got = splitByWidth("\x1b[1m\x1b[32mtesttes", 4)
+	want = []string{"\x1b[1m\x1b[32mtest\x1b[0m", "\x1b[1m\x1b[32mtes\x1b[0m"}
+	assert.Equal(t, want, got)
+}
+
+func TestStyledTable(t *testing.T) {
+	t.Run("Colored values keep alignment", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "status"},
+			[][]interface{}{{1, 2}, {FgGreen.Apply("ok"), FgRed.Apply("failed")}},
+		)
+		tab.Options.SetCellFitContent(true)
+		assert.Equal(t, []int{2, 6}, tab.maxColLength)
+
+		got := tab.Draw()
+		want := "#======#==========#\n" +
+			"#  id  #  status  #\n" +
+			"#======#==========#\n" +
+			"|  1   |    \x1b[32mok\x1b[0m    |\n" +
+			"+------+----------+\n" +
+			"|  2   |  \x1b[31mfailed\x1b[0m  |\n" +
+			"+------+----------+\n"
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Header, frame and row styles", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id"},
+			[][]interface{}{{1, 2}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetHeaderStyle(Bold)
+		tab.Options.SetFrameStyle(Dim)
+		tab.Options.SetColumnStyle(0, Underline)
+		tab.Options.SetRowStyle(1, FgRed)
+
+		got := tab.Draw()
+		want := "\x1b[2m#======#\x1b[0m\n" +
+			"\x1b[2m#\x1b[0m  \x1b[1mid\x1b[0m  \x1b[2m#\x1b[0m\n" +
+			"\x1b[2m#======#\x1b[0m\n" +
+			"\x1b[2m|\x1b[0m  \x1b[4m1\x1b[0m   \x1b[2m|\x1b[0m\n" +
+			"\x1b[2m+------+\x1b[0m\n" +
+			"\x1b[2m|\x1b[0m  \x1b[4;31m2\x1b[0m   \x1b[2m|\x1b[0m\n" +
+			"\x1b[2m+------+\x1b[0m\n"
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Colored value split across lines", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{},
+			[][]interface{}{{FgRed.Apply("testtesttest")}},
+		)
+		tab.Options.SetCellLength(8)
+
+		got := tab.Draw()
+		want := "+------------+\n" +
+			"|  \x1b[31mtesttest\x1b[0m  |\n" +
+			"|    \x1b[31mtest\x1b[0m    |\n" +
+			"+------------+\n"
+		assert.Equal(t, want, got)
+	})
+}
@@ -0,0 +1,7 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+package tymbol
+
+func terminalWidth(fd uintptr) (int, bool) {
+	return 0, false
+}
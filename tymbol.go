@@ -2,7 +2,8 @@ package tymbol
 
 import (
 	"fmt"
-	"math"
+	"io"
+	"reflect"
 	"strings"
 )
 
@@ -15,14 +16,21 @@ type Table struct {
 	Title   string
 	headers []string
 	columns [][]string
+	values  [][]interface{}
 	Options Options
 
-	cellLength   int
-	tableLength  int
 	maxColLength []int
 	maxRowLength []int
 
-	canvas strings.Builder
+	columnFormatters map[int]Formatter
+	typeFormatters   map[reflect.Type]Formatter
+
+	footer           []interface{}
+	footerAggregates map[int]string
+
+	spans       map[cellIndex]cellSpan
+	headerSpans map[int]int
+	headerTree  []Header
 }
 
 func NewTable(title string, headers []string, columns [][]interface{}) (Table, error) {
@@ -54,25 +62,29 @@ func NewTable(title string, headers []string, columns [][]interface{}) (Table, e
 	maxRowLength := make([]int, numberOfRows)
 
 	for i := range headers {
-		maxColLength[i] = len(headers[i])
-		if len(headers) > 0 && len(headers[i]) > maxRowLength[0] {
-			maxRowLength[0] = len(headers[i])
+		width := blockWidth(headers[i])
+		maxColLength[i] = width
+		if len(headers) > 0 && width > maxRowLength[0] {
+			maxRowLength[0] = width
 		}
 	}
 
+	values := make([][]interface{}, len(columns))
 	strColumns := make([][]string, len(columns))
 	for i := range columns {
+		values[i] = append([]interface{}{}, columns[i]...)
 		for j := range columns[i] {
 			val := fmt.Sprintf("%v", columns[i][j])
-			if maxColLength[i] < len(val) {
-				maxColLength[i] = len(val)
+			width := blockWidth(val)
+			if maxColLength[i] < width {
+				maxColLength[i] = width
 			}
 			var extraIndex int
 			if len(headers) > 0 {
 				extraIndex = 1
 			}
-			if maxRowLength[j+extraIndex] < len(val) {
-				maxRowLength[j+extraIndex] = len(val)
+			if maxRowLength[j+extraIndex] < width {
+				maxRowLength[j+extraIndex] = width
 			}
 			strColumns[i] = append(strColumns[i], val)
 		}
@@ -82,6 +94,7 @@ func NewTable(title string, headers []string, columns [][]interface{}) (Table, e
 		Title:        title,
 		headers:      headers,
 		columns:      strColumns,
+		values:       values,
 		Options:      defaultOptions(),
 		maxColLength: maxColLength,
 		maxRowLength: maxRowLength,
@@ -90,205 +103,344 @@ func NewTable(title string, headers []string, columns [][]interface{}) (Table, e
 	return t, nil
 }
 
-func (t *Table) ResetCanvas() {
-	t.canvas.Reset()
+// measureContent recomputes maxColLength and maxRowLength from headers and
+// values.
+func (t *Table) measureContent() {
+	offset := t.headerOffset()
+	t.maxColLength = make([]int, len(t.columns))
+	t.maxRowLength = make([]int, t.NumRows()+offset)
+	for i := range t.headers {
+		if t.covered(-1, i) || t.headerSpans[i] > 1 {
+			continue
+		}
+		width := blockWidth(t.headers[i])
+		t.maxColLength[i] = width
+		if width > t.maxRowLength[0] {
+			t.maxRowLength[0] = width
+		}
+	}
+	for i := range t.columns {
+		for j, val := range t.columns[i] {
+			if t.covered(j, i) || t.span(j, i).cols > 1 {
+				continue
+			}
+			width := blockWidth(val)
+			if t.maxColLength[i] < width {
+				t.maxColLength[i] = width
+			}
+			if t.maxRowLength[j+offset] < width {
+				t.maxRowLength[j+offset] = width
+			}
+		}
+	}
 }
 
+// ResetCanvas does nothing, Draw doesn't keep previous output anymore.
+//
+// Deprecated: Draw can be called repeatedly without resetting.
+func (t *Table) ResetCanvas() {}
+
+// Draw returns the table as a string. It's safe to call Draw concurrently as
+// long as the table isn't modified.
 func (t *Table) Draw() string {
+	var b strings.Builder
+	t.Render(&b)
+	return b.String()
+}
 
-	if !t.Options.CellFitContent() {
-		t.cellLength = 2*t.Options.CellPadding() + t.Options.CellLength()
-		t.tableLength = t.cellLength*len(t.columns) + len(t.columns) + 1
-	} else {
-		for i := 0; i < len(t.columns); i++ {
-			t.tableLength += 2*t.Options.CellPadding() + t.maxColLength[i]
+// Render writes the table to w line by line.
+func (t *Table) Render(w io.Writer) error {
+	_, err := t.WriteTo(w)
+	return err
+}
+
+// WriteTo writes the table to w line by line and returns the number of
+// written bytes. It stops at the first write error.
+func (t *Table) WriteTo(w io.Writer) (int64, error) {
+	r := renderer{t: t, l: t.layout(), w: w}
+	border := t.Options.BorderStyle()
+	if t.Options.PageSize() > 0 {
+		pages := r.pages(border)
+		for i := range pages {
+			r.drawPage(border, pages, i)
 		}
-		t.tableLength += len(t.columns) + 1
+		return r.n, r.err
 	}
-
-	t.drawTitle()
-	t.drawHeader()
-	t.drawBody()
-	return t.canvas.String()
+	r.drawTitle()
+	r.drawHeader(border)
+	r.drawBody(border)
+	return r.n, r.err
 }
 
-func (t *Table) newLine() {
-	t.canvas.WriteString(NEW_LINE)
+// renderer writes lines of a table to w, each line is composed in line
+// before it's written.
+type renderer struct {
+	t    *Table
+	l    layout
+	w    io.Writer
+	line strings.Builder
+	n    int64
+	err  error
 }
 
-func (t *Table) getLengthByIndex(idx int) int {
-	if t.Options.CellFitContent() {
-		return t.maxColLength[idx] + 2*t.Options.CellPadding()
+func (r *renderer) newLine() {
+	r.line.WriteString(NEW_LINE)
+	if r.err == nil {
+		n, err := io.WriteString(r.w, r.line.String())
+		r.n += int64(n)
+		r.err = err
 	}
-	return t.cellLength
+	r.line.Reset()
+}
+
+func (r *renderer) getLengthByIndex(idx int) int {
+	return r.l.cellLengths[idx] + 2*r.l.paddings[idx]
 }
 
-func (t *Table) drawLine(hasLeft, hasRight bool, crossSym, hSym rune, cellLength int) {
-	if hasLeft {
-		t.canvas.WriteRune(crossSym)
+func (r *renderer) writeBorder(s string) {
+	r.line.WriteString(r.t.Options.FrameStyle().Apply(s))
+}
+
+func (r *renderer) drawLine(border BorderLine) {
+	if border.Line == 0 {
+		return
 	}
 
-	for i := 0; i < cellLength; i++ {
-		t.canvas.WriteRune(hSym)
+	var line strings.Builder
+	if r.t.Options.OuterBorder() {
+		line.WriteRune(border.Left)
+	}
+	for i := range r.t.columns {
+		if i > 0 && r.t.Options.InnerBorder() {
+			line.WriteRune(border.Cross)
+		}
+		for j := 0; j < r.getLengthByIndex(i); j++ {
+			line.WriteRune(border.Line)
+		}
 	}
-	if hasRight {
-		t.canvas.WriteRune(crossSym)
+	if r.t.Options.OuterBorder() {
+		line.WriteRune(border.Right)
 	}
+	r.writeBorder(line.String())
+	r.newLine()
 }
 
-func (t *Table) drawValueLine(hasLeft, hasRight bool, vSym rune, lineAlign align, cellLength int, v string) {
-	if hasLeft {
-		t.canvas.WriteRune(vSym)
-	}
-
+func (r *renderer) drawValueLine(lineAlign align, padding, cellLength int, style Style, v string) {
+	width := stringWidth(v)
 	var left, right int
 	switch lineAlign {
 	case CENTER:
-		left = (cellLength - len(v)) / 2
-		right = cellLength - len(v) - left
+		left = (cellLength - width) / 2
+		right = cellLength - width - left
 	case LEFT:
-		left = t.Options.CellPadding()
-		right = cellLength - left - len(v)
-	case RIGHT:
-		left = cellLength - t.Options.CellPadding() - len(v)
-		right = t.Options.CellPadding()
+		left = padding
+		right = cellLength - left - width
+	case RIGHT, DECIMAL:
+		left = cellLength - padding - width
+		right = padding
 	}
 
 	for i := 0; i < left; i++ {
-		t.canvas.WriteString(SPACE)
+		r.line.WriteString(SPACE)
 	}
-	t.canvas.WriteString(v)
+	r.line.WriteString(style.Apply(v))
 	for i := 0; i < right; i++ {
-		t.canvas.WriteString(SPACE)
-	}
-	if hasRight {
-		t.canvas.WriteRune(vSym)
+		r.line.WriteString(SPACE)
 	}
 }
 
-func (t *Table) drawValueMultiLine(hasLeft, hasRight bool, vSym rune, lineAlign align, cellLength int, rowHeight int, filledRows int, cursor int, v string) {
-	upperPadding := (rowHeight - filledRows) / 2
-	if cursor < upperPadding || cursor >= filledRows+upperPadding {
-		t.drawValueLine(hasLeft, hasRight, vSym, lineAlign, cellLength, SPACE)
+func (r *renderer) drawValueMultiLine(lineAlign align, padding, cellLength int, style Style, rowHeight int, cursor int, lines []string) {
+	upperPadding := (rowHeight - len(lines)) / 2
+	if cursor < upperPadding || cursor >= len(lines)+upperPadding {
+		r.drawValueLine(lineAlign, padding, cellLength, "", SPACE)
 	} else {
-		position := (cursor - upperPadding) * t.Options.CellLength()
-		charsLeft := len(v) - position
-		if charsLeft < t.Options.CellLength() {
-			t.drawValueLine(hasLeft, hasRight, vSym, lineAlign, cellLength, v[position:])
-		} else {
-			t.drawValueLine(hasLeft, hasRight, vSym, lineAlign, cellLength, v[position:position+t.Options.CellLength()])
-		}
+		r.drawValueLine(lineAlign, padding, cellLength, style, lines[cursor-upperPadding])
 	}
+}
 
+func (r *renderer) cellLines(col int, v string) []string {
+	return r.cellLinesWidth(col, v, r.l.cellLengths[col])
 }
 
-func (t *Table) drawHeader() {
-	if len(t.headers) == 0 {
-		return
+// cellLinesWidth splits the value of the column into lines of width.
+func (r *renderer) cellLinesWidth(col int, v string, width int) []string {
+	if v == "" {
+		return nil
 	}
 
-	for i := 0; i < len(t.headers); i++ {
-		hasLeft, hasRight := false, true
-		if i == 0 {
-			hasLeft, hasRight = true, true
+	t := r.t
+	c := t.ColumnOptions(col)
+	if c.Overflow() != OVERFLOW_WRAP {
+		return []string{truncateText(v, width, c.Overflow(), t.Options.Ellipsis())}
+	}
+	if c.FitContent() && (width >= c.CellLength() || blockWidth(v) <= width) {
+		return balanceEscapes(splitParagraphs(v))
+	}
+	return wrapText(v, width, c.WrapMode())
+}
+
+// drawRow draws values of a single row, align and style of every cell are
+// given by cellFormat.
+func (r *renderer) drawRow(border BorderVertical, values []string, cellFormat func(col int) (align, Style)) {
+	lines := make([][]string, len(values))
+	aligns := make([]align, len(values))
+	styles := make([]Style, len(values))
+	rowHeight := 1
+	for i, v := range values {
+		aligns[i], styles[i] = cellFormat(i)
+		lines[i] = r.cellLines(i, v)
+		if aligns[i] == DECIMAL {
+			lines[i] = r.alignDecimal(i, lines[i])
 		}
-		t.drawLine(hasLeft, hasRight, t.Options.CrossHeaderSym(), t.Options.HHeaderSym(), t.getLengthByIndex(i))
-	}
-	t.newLine()
-	rowHeight := int(math.Ceil(float64(t.maxRowLength[0]) / float64(t.Options.CellLength())))
-	for j := 0; j < rowHeight; j++ {
-		for i := 0; i < len(t.headers); i++ {
-			hasLeft, hasRight := false, true
-			if i == 0 {
-				hasLeft, hasRight = true, true
-			}
-			if !t.Options.CellFitContent() {
-				filledRows := int(math.Ceil(float64(len(t.headers[i])) / float64(t.Options.CellLength())))
-				t.drawValueMultiLine(hasLeft, hasRight, t.Options.VHeaderSym(), t.Options.HeaderAlign(), t.getLengthByIndex(i), rowHeight, filledRows, j, t.headers[i])
-			} else {
-				t.drawValueLine(hasLeft, hasRight, t.Options.VHeaderSym(), t.Options.HeaderAlign(), t.getLengthByIndex(i), t.headers[i])
-			}
+		if len(lines[i]) > rowHeight {
+			rowHeight = len(lines[i])
 		}
-		t.newLine()
 	}
-	for i := 0; i < len(t.headers); i++ {
-		hasLeft, hasRight := false, true
-		if i == 0 {
-			hasLeft, hasRight = true, true
+
+	for n := 0; n < rowHeight; n++ {
+		if r.t.Options.OuterBorder() {
+			r.writeBorder(string(border.Left))
+		}
+		for i := range values {
+			if i > 0 && r.t.Options.InnerBorder() {
+				r.writeBorder(string(border.Middle))
+			}
+			r.drawValueMultiLine(aligns[i], r.l.paddings[i], r.getLengthByIndex(i), styles[i], rowHeight, n, lines[i])
+		}
+		if r.t.Options.OuterBorder() {
+			r.writeBorder(string(border.Right))
 		}
-		t.drawLine(hasLeft, hasRight, t.Options.CrossHeaderSym(), t.Options.HHeaderSym(), t.getLengthByIndex(i))
+		r.newLine()
 	}
-	t.newLine()
 }
 
-func (t *Table) drawBody() {
-	headerIndex := 1
-	if len(t.headers) == 0 {
-		headerIndex = 0
-		for i := range t.columns {
-			hasLeft, hasRight := false, true
-			if i == 0 {
-				hasLeft, hasRight = true, true
-			}
-			t.drawLine(hasLeft, hasRight, t.Options.CrossLineSym(), t.Options.HLineSym(), t.getLengthByIndex(i))
+func (r *renderer) drawHeader(border BorderStyle) {
+	if len(r.t.headers) == 0 {
+		return
+	}
+	if r.t.hasSpans() {
+		r.drawSpannedHeader(border)
+		return
+	}
+
+	if r.t.Options.OuterBorder() {
+		r.drawLine(border.HeaderTop)
+	}
+	r.drawRow(border.Header, r.t.headers, func(int) (align, Style) {
+		if r.t.Options.HeaderAlign() == DECIMAL {
+			return RIGHT, r.t.Options.HeaderStyle()
 		}
-		t.newLine()
-	}
-
-	for i := 0; i < len(t.columns[0]); i++ {
-		rowHeight := int(math.Ceil(float64(t.maxRowLength[i+headerIndex]) / float64(t.Options.CellLength())))
-		for n := 0; n < rowHeight; n++ {
-			for j := range t.columns {
-				hasLeft, hasRight := false, true
-				if j == 0 {
-					hasLeft, hasRight = true, true
-				}
-				if !t.Options.CellFitContent() {
-					filledRows := int(math.Ceil(float64(len(t.columns[j][i])) / float64(t.Options.CellLength())))
-					t.drawValueMultiLine(hasLeft, hasRight, t.Options.VLineSym(), t.Options.CellAlign(), t.getLengthByIndex(j), rowHeight, filledRows, n, t.columns[j][i])
-				} else {
-					t.drawValueLine(hasLeft, hasRight, t.Options.VLineSym(), t.Options.CellAlign(), t.getLengthByIndex(j), t.columns[j][i])
-				}
+		return r.t.Options.HeaderAlign(), r.t.Options.HeaderStyle()
+	})
+	r.drawLine(border.HeaderBottom)
+}
+
+func (r *renderer) drawBody(border BorderStyle) {
+	r.drawRows(border, 0, r.t.NumRows())
+}
+
+// drawRows draws body rows with indexes from from to to, with the top border
+// for tables without headers and the bottom border. The footer is drawn
+// instead of the bottom border after the last row of the table.
+func (r *renderer) drawRows(border BorderStyle, from, to int) {
+	if r.t.hasSpans() {
+		r.drawSpannedRows(border, from, to)
+		return
+	}
+	if len(r.t.headers) == 0 && r.t.Options.OuterBorder() {
+		r.drawLine(border.Top)
+	}
+
+	for i := from; i < to; i++ {
+		r.drawBodyRow(border, i, r.t.row(i))
+
+		switch {
+		case i == r.t.NumRows()-1 && r.l.footer != nil:
+		case i == to-1:
+			if r.t.Options.OuterBorder() {
+				r.drawLine(border.Bottom)
 			}
-			t.newLine()
+		case r.t.Options.InnerBorder():
+			r.drawLine(border.Row)
 		}
-		for j := range t.columns {
-			hasLeft, hasRight := false, true
-			if j == 0 {
-				hasLeft, hasRight = true, true
-			}
-			t.drawLine(hasLeft, hasRight, t.Options.CrossLineSym(), t.Options.HLineSym(), t.getLengthByIndex(j))
+	}
+	if to == r.t.NumRows() && r.l.footer != nil {
+		r.drawFooter(border)
+	}
+}
+
+func (r *renderer) drawFooter(border BorderStyle) {
+	r.drawFooterBelow(border, nil)
+}
+
+// drawFooterBelow draws the footer below a row with given owners of cells,
+// see drawSpanLine.
+func (r *renderer) drawFooterBelow(border BorderStyle, above []cellIndex) {
+	if above != nil {
+		footer := make([]cellIndex, len(r.t.columns))
+		for c := range footer {
+			footer[c] = cellIndex{r.t.NumRows(), c}
 		}
-		t.newLine()
+		r.drawSpanLine(border.Body, border.FooterTop, above, footer)
+	} else {
+		r.drawLine(border.FooterTop)
+	}
+	r.drawRow(border.Footer, r.l.footer, func(col int) (align, Style) {
+		c := r.t.ColumnOptions(col)
+		return c.CellAlign(), r.t.Options.FooterStyle()
+	})
+	if r.t.Options.OuterBorder() {
+		r.drawLine(border.FooterBottom)
+	}
+}
+
+func (t *Table) row(i int) []string {
+	row := make([]string, len(t.columns))
+	for j := range t.columns {
+		row[j] = t.columns[j][i]
 	}
+	return row
 }
 
-func (t *Table) drawTitle() {
-	if t.Title == "" {
+func (r *renderer) drawBodyRow(border BorderStyle, i int, row []string) {
+	r.drawRow(border.Body, row, func(col int) (align, Style) {
+		c := r.t.ColumnOptions(col)
+		return c.CellAlign(), r.t.Options.bodyStyle(i, col)
+	})
+}
+
+func (r *renderer) drawTitle() {
+	if r.t.Title == "" {
 		return
 	}
+	r.drawCaption(r.t.Title, r.t.Options.TitleAlign(), r.t.Options.TitleStyle())
+}
 
+// drawCaption draws a line of text aligned within the table length.
+func (r *renderer) drawCaption(text string, lineAlign align, style Style) {
+	text = expandTabs(text)
+	width := stringWidth(text)
 	var left, right int
-	switch t.Options.TitleAlign() {
+	switch lineAlign {
 	case CENTER:
-		left = (t.tableLength - len(t.Title)) / 2
-		right = t.tableLength - len(t.Title) - left
+		left = (r.l.tableLength - width) / 2
+		right = r.l.tableLength - width - left
 	case LEFT:
 		left = 0
-		right = t.tableLength - len(t.Title)
-	case RIGHT:
-		left = t.tableLength - len(t.Title)
+		right = r.l.tableLength - width
+	case RIGHT, DECIMAL:
+		left = r.l.tableLength - width
 		right = 0
 	}
 
 	for i := 0; i < left; i++ {
-		t.canvas.WriteString(SPACE)
+		r.line.WriteString(SPACE)
 	}
 
-	t.canvas.WriteString(t.Title)
+	r.line.WriteString(style.Apply(text))
 
 	for i := 0; i < right; i++ {
-		t.canvas.WriteString(SPACE)
+		r.line.WriteString(SPACE)
 	}
-	t.newLine()
+	r.newLine()
 }
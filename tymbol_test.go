@@ -1,6 +1,9 @@
 package tymbol
 
 import (
+	"bytes"
+	"errors"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -19,6 +22,7 @@ func TestNewTable(t *testing.T) {
 			Title:        "test",
 			headers:      []string{"h1", "h2", "h3"},
 			columns:      [][]string{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}, {"c31", "c32", "c33"}},
+			values:       [][]interface{}{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}, {"c31", "c32", "c33"}},
 			maxColLength: []int{3, 3, 3},
 			maxRowLength: []int{2, 3, 3, 3},
 			Options: Options{
@@ -30,6 +34,9 @@ func TestNewTable(t *testing.T) {
 				cellLength:     10,
 				cellPadding:    2,
 				cellAlign:      "center",
+				wrapMode:       WRAP_CHAR,
+				overflow:       OVERFLOW_WRAP,
+				ellipsis:       "…",
 				crossLineSym:   '+',
 				vLineSym:       '|',
 				hLineSym:       '-',
@@ -135,6 +142,7 @@ func TestTableChangeSymbols(t *testing.T) {
 		Title:        "",
 		headers:      []string{"h1", "h2", "h3"},
 		columns:      [][]string{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}, {"c31", "c32", "c33"}},
+		values:       [][]interface{}{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}, {"c31", "c32", "c33"}},
 		maxColLength: []int{3, 3, 3},
 		maxRowLength: []int{2, 3, 3, 3},
 		Options: Options{
@@ -143,6 +151,9 @@ func TestTableChangeSymbols(t *testing.T) {
 			cellLength:     10,
 			cellPadding:    2,
 			cellAlign:      "center",
+			wrapMode:       WRAP_CHAR,
+			overflow:       OVERFLOW_WRAP,
+			ellipsis:       "…",
 			crossHeaderSym: 'o',
 			vHeaderSym:     'o',
 			hHeaderSym:     'o',
@@ -187,6 +198,7 @@ func TestTableAlign(t *testing.T) {
 			Title:        "test",
 			headers:      []string{"h1", "h2"},
 			columns:      [][]string{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}},
+			values:       [][]interface{}{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}},
 			maxColLength: []int{3, 3},
 			maxRowLength: []int{2, 3, 3, 3},
 			Options: Options{
@@ -195,6 +207,9 @@ func TestTableAlign(t *testing.T) {
 				cellLength:     10,
 				cellPadding:    2,
 				cellAlign:      "left",
+				wrapMode:       WRAP_CHAR,
+				overflow:       OVERFLOW_WRAP,
+				ellipsis:       "…",
 				crossHeaderSym: '#',
 				vHeaderSym:     '#',
 				hHeaderSym:     '=',
@@ -237,6 +252,7 @@ func TestTableAlign(t *testing.T) {
 			Title:        "test",
 			headers:      []string{"h1", "h2"},
 			columns:      [][]string{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}},
+			values:       [][]interface{}{{"c11", "c12", "c13"}, {"c21", "c22", "c23"}},
 			maxColLength: []int{3, 3},
 			maxRowLength: []int{2, 3, 3, 3},
 			Options: Options{
@@ -245,6 +261,9 @@ func TestTableAlign(t *testing.T) {
 				cellPadding:    2,
 				headerAlign:    "right",
 				cellAlign:      "right",
+				wrapMode:       WRAP_CHAR,
+				overflow:       OVERFLOW_WRAP,
+				ellipsis:       "…",
 				crossHeaderSym: '#',
 				vHeaderSym:     '#',
 				hHeaderSym:     '=',
@@ -318,6 +337,88 @@ func TestFitContentTable(t *testing.T) {
 `
 		assert.Equal(t, want, got)
 	})
+
+	t.Run("Decimal align", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "key", "value"},
+			[][]interface{}{{1, 2, 3}, {"test1", "test2", "test3"}, {3.14, 0.3, 1.0000003}},
+		)
+		tab.Options.SetCellFitContent(true)
+		var value ColumnOptions
+		value.SetCellAlign(DECIMAL)
+		tab.Options.SetColumnOptionsByHeader("value", value)
+		got := tab.Draw()
+
+		want := "#======#=========#=============#\n" +
+			"#  id  #   key   #    value    #\n" +
+			"#======#=========#=============#\n" +
+			"|  1   |  test1  |  3.14       |\n" +
+			"+------+---------+-------------+\n" +
+			"|  2   |  test2  |  0.3        |\n" +
+			"+------+---------+-------------+\n" +
+			"|  3   |  test3  |  1.0000003  |\n" +
+			"+------+---------+-------------+\n"
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Decimal align widens column", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"n"},
+			[][]interface{}{{1234, 0.125, "-"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetCellAlign(DECIMAL)
+		got := tab.Draw()
+
+		want := "#==========#\n" +
+			"#    n     #\n" +
+			"#==========#\n" +
+			"| 1234     |\n" +
+			"+----------+\n" +
+			"|    0.125 |\n" +
+			"+----------+\n" +
+			"|        - |\n" +
+			"+----------+\n"
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Decimal align skips other values", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"n"},
+			[][]interface{}{{"not available", 1.5, 12.25, 123456789.125}},
+		)
+		tab.Options.SetCellLength(9)
+		tab.Options.SetCellPadding(1)
+		tab.Options.SetCellAlign(DECIMAL)
+		tab.Options.SetOverflow(OVERFLOW_TRUNCATE)
+		got := tab.Draw()
+
+		want := "#===========#\n" +
+			"#     n     #\n" +
+			"#===========#\n" +
+			"| not avai… |\n" +
+			"+-----------+\n" +
+			"|      1.5  |\n" +
+			"+-----------+\n" +
+			"|     12.25 |\n" +
+			"+-----------+\n" +
+			"| 1.234567… |\n" +
+			"+-----------+\n"
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestIsDecimalNumber(t *testing.T) {
+	for _, v := range []string{"0", "-1.5", "+.25", "1,234.50", "9 876", "1e+21", "25%", "\x1b[31m3.14\x1b[0m"} {
+		assert.True(t, isDecimalNumber(v), v)
+	}
+	for _, v := range []string{"", "-", "n/a", "1.", ".", "1.2.3", "e5", "1e", ",1", "12 KB"} {
+		assert.False(t, isDecimalNumber(v), v)
+	}
 }
 
 func TestMultiLineTable(t *testing.T) {
@@ -333,6 +434,7 @@ func TestMultiLineTable(t *testing.T) {
 			Title:        "",
 			headers:      []string{"id", "value"},
 			columns:      [][]string{{"1", "2", "3"}, {"testtesttesttesttesttest", "testtesttest", "testtest"}},
+			values:       [][]interface{}{{1, 2, 3}, {"testtesttesttesttesttest", "testtesttest", "testtest"}},
 			maxColLength: []int{2, 24},
 			maxRowLength: []int{5, 24, 12, 8},
 			Options: Options{
@@ -341,6 +443,9 @@ func TestMultiLineTable(t *testing.T) {
 				cellLength:     8,
 				cellPadding:    2,
 				cellAlign:      "center",
+				wrapMode:       WRAP_CHAR,
+				overflow:       OVERFLOW_WRAP,
+				ellipsis:       "…",
 				crossHeaderSym: '#',
 				vHeaderSym:     '#',
 				hHeaderSym:     '=',
@@ -387,3 +492,125 @@ func TestMultiLineTable(t *testing.T) {
 	})
 
 }
+
+type limitedWriter struct {
+	lines int
+	buf   bytes.Buffer
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.lines == 0 {
+		return 0, errors.New("no space left")
+	}
+	w.lines--
+	return w.buf.Write(p)
+}
+
+func TestRender(t *testing.T) {
+	t.Run("Same output as Draw", func(t *testing.T) {
+		tab, _ := NewTable(
+			"test",
+			[]string{"h1", "h2"},
+			[][]interface{}{{"c11", "c12"}, {"c21", "c22"}},
+		)
+		var buf bytes.Buffer
+		n, err := tab.WriteTo(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+
+		want := `             test              
+#==============#==============#
+#      h1      #      h2      #
+#==============#==============#
+|     c11      |     c21      |
++--------------+--------------+
+|     c12      |     c22      |
++--------------+--------------+
+`
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("Write error", func(t *testing.T) {
+		tab, _ := NewTable(
+			"test",
+			[]string{"h1", "h2"},
+			[][]interface{}{{"c11", "c12"}, {"c21", "c22"}},
+		)
+		w := &limitedWriter{lines: 2}
+		err := tab.Render(w)
+		if assert.Error(t, err) {
+			assert.Equal(t, "no space left", err.Error())
+		}
+		want := `             test              
+#==============#==============#
+`
+		assert.Equal(t, want, w.buf.String())
+	})
+}
+
+func TestDrawIdempotent(t *testing.T) {
+	t.Run("Repeated draws", func(t *testing.T) {
+		tab, _ := NewTable(
+			"test",
+			[]string{"h1", "h2"},
+			[][]interface{}{{"c11", "c12"}, {"c21", "c22"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		first := tab.Draw()
+		assert.Equal(t, first, tab.Draw())
+		assert.Equal(t, first, tab.Draw())
+	})
+
+	t.Run("Options change invalidates layout", func(t *testing.T) {
+		tab, _ := NewTable(
+			"test",
+			[]string{"h1", "h2"},
+			[][]interface{}{{"c11", "c12"}, {"c21", "c22"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Draw()
+		tab.Options.SetCellPadding(0)
+
+		want := `  test   
+#===#===#
+#h1 #h2 #
+#===#===#
+|c11|c21|
++---+---+
+|c12|c22|
++---+---+
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+
+	t.Run("Concurrent draws", func(t *testing.T) {
+		tab, _ := NewTable(
+			"test",
+			[]string{"h1", "h2"},
+			[][]interface{}{{"c11", "c12"}, {"c21", "c22"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		want := `      test       
+#=======#=======#
+#  h1   #  h2   #
+#=======#=======#
+|  c11  |  c21  |
++-------+-------+
+|  c12  |  c22  |
++-------+-------+
+`
+		var wg sync.WaitGroup
+		got := make([]string, 8)
+		for i := range got {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				got[i] = tab.Draw()
+			}(i)
+		}
+		wg.Wait()
+		for i := range got {
+			assert.Equal(t, want, got[i])
+		}
+	})
+}
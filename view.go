@@ -0,0 +1,260 @@
+package tymbol
+
+import "fmt"
+
+// Row gives access to values of a single row in Filter.
+type Row struct {
+	t   *Table
+	idx int
+}
+
+// Index returns index of the row in the table.
+func (r Row) Index() int {
+	return r.idx
+}
+
+// Value returns the original value of the column with index col.
+func (r Row) Value(col int) interface{} {
+	return r.t.values[col][r.idx]
+}
+
+// ValueByHeader returns the original value of the column named header.
+func (r Row) ValueByHeader(header string) (interface{}, bool) {
+	col, ok := r.t.headerIndex(header)
+	if !ok {
+		return nil, false
+	}
+	return r.Value(col), true
+}
+
+// String returns the formatted value of the column with index col.
+func (r Row) String(col int) string {
+	return r.t.columns[col][r.idx]
+}
+
+// Filter returns a new table with rows for which keep returns true.
+func (t *Table) Filter(keep func(row Row) bool) Table {
+	var rows []int
+	for i := 0; i < t.NumRows(); i++ {
+		if keep(Row{t: t, idx: i}) {
+			rows = append(rows, i)
+		}
+	}
+	return t.view(rows, nil)
+}
+
+// Select returns a new table with given columns, each column is either a
+// header name or an index. Bands of a header tree and header spans are kept
+// for columns selected in their original order, otherwise they're dropped.
+func (t *Table) Select(columns ...interface{}) (Table, error) {
+	if len(columns) == 0 {
+		return Table{}, fmt.Errorf("Columns cannot be empty!")
+	}
+
+	cols := make([]int, len(columns))
+	for i, c := range columns {
+		switch c := c.(type) {
+		case int:
+			if c < 0 || c >= len(t.columns) {
+				return Table{}, fmt.Errorf("Column index out of range: %d", c)
+			}
+			cols[i] = c
+		case string:
+			idx, ok := t.headerIndex(c)
+			if !ok {
+				return Table{}, fmt.Errorf("Unknown column: %s", c)
+			}
+			cols[i] = idx
+		default:
+			return Table{}, fmt.Errorf("Column must be a header name or an index, got %T", c)
+		}
+	}
+	return t.view(nil, cols), nil
+}
+
+// Head returns a new table with the first n rows.
+func (t *Table) Head(n int) Table {
+	return t.view(t.rowRange(0, n), nil)
+}
+
+// Tail returns a new table with the last n rows.
+func (t *Table) Tail(n int) Table {
+	if n > t.NumRows() {
+		n = t.NumRows()
+	}
+	if n < 0 {
+		n = 0
+	}
+	return t.view(t.rowRange(t.NumRows()-n, n), nil)
+}
+
+func (t *Table) rowRange(from, n int) []int {
+	if n > t.NumRows()-from {
+		n = t.NumRows() - from
+	}
+	if n < 0 {
+		n = 0
+	}
+	rows := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		rows = append(rows, from+i)
+	}
+	return rows
+}
+
+func (t *Table) headerIndex(header string) (int, bool) {
+	for i := range t.headers {
+		if t.headers[i] == header {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// view returns a new table with given rows and columns in the given order.
+// Nil rows or cols keep all of them. Options indexed by rows and columns are
+// moved to new indexes.
+func (t *Table) view(rows, cols []int) Table {
+	if rows == nil {
+		rows = t.rowRange(0, t.NumRows())
+	}
+	if cols == nil {
+		cols = make([]int, len(t.columns))
+		for i := range cols {
+			cols[i] = i
+		}
+	}
+
+	v := Table{
+		Title:   t.Title,
+		columns: make([][]string, len(cols)),
+		values:  make([][]interface{}, len(cols)),
+	}
+	if len(t.headers) > 0 {
+		v.headers = make([]string, len(cols))
+	}
+	for i, col := range cols {
+		if v.headers != nil {
+			v.headers[i] = t.headers[col]
+		}
+		v.columns[i] = make([]string, len(rows))
+		v.values[i] = make([]interface{}, len(rows))
+		for j, row := range rows {
+			v.columns[i][j] = t.columns[col][row]
+			v.values[i][j] = t.values[col][row]
+		}
+	}
+
+	rowIndex := make(map[int]int, len(rows))
+	for i, row := range rows {
+		rowIndex[row] = i
+	}
+	colIndex := make(map[int]int, len(cols))
+	for i, col := range cols {
+		colIndex[col] = i
+	}
+	v.Options = t.Options.remap(rowIndex, colIndex)
+	v.headerTree, v.headerSpans = t.viewHeaders(cols)
+	v.columnFormatters = remapKeys(t.columnFormatters, colIndex)
+	v.typeFormatters = t.typeFormatters
+	v.footerAggregates = remapKeys(t.footerAggregates, colIndex)
+	if t.footer != nil {
+		v.footer = make([]interface{}, len(cols))
+		for i, col := range cols {
+			v.footer[i] = t.footer[col]
+		}
+	}
+	v.measureContent()
+	return v
+}
+
+// viewHeaders returns the header tree and header spans of a view with given
+// columns. Both are pruned to the columns if they keep their order.
+func (t *Table) viewHeaders(cols []int) ([]Header, map[int]int) {
+	for i := 1; i < len(cols); i++ {
+		if cols[i] <= cols[i-1] {
+			return nil, nil
+		}
+	}
+	selected := make(map[int]int, len(cols))
+	for i, col := range cols {
+		selected[col] = i
+	}
+
+	var tree []Header
+	if t.headerTree != nil {
+		var leaf int
+		var prune func(nodes []Header) []Header
+		prune = func(nodes []Header) []Header {
+			var pruned []Header
+			for _, n := range nodes {
+				if len(n.Children) == 0 {
+					if _, ok := selected[leaf]; ok {
+						pruned = append(pruned, n)
+					}
+					leaf++
+					continue
+				}
+				if children := prune(n.Children); children != nil {
+					pruned = append(pruned, Header{n.Name, children})
+				}
+			}
+			return pruned
+		}
+		tree = prune(t.headerTree)
+	}
+
+	var spans map[int]int
+	for c, n := range t.headerSpans {
+		i, ok := selected[c]
+		if !ok || i+n > len(cols) || cols[i+n-1] != c+n-1 {
+			continue
+		}
+		if spans == nil {
+			spans = make(map[int]int)
+		}
+		spans[i] = n
+	}
+	return tree, spans
+}
+
+// remap returns a copy of options with row and column indexes changed from
+// keys to values of rows and cols. Options of missing indexes are dropped.
+func (o Options) remap(rows, cols map[int]int) Options {
+	o.columnOptions = remapKeys(o.columnOptions, cols)
+	o.columnStyles = remapKeys(o.columnStyles, cols)
+	o.rowStyles = remapKeys(o.rowStyles, rows)
+	o.rowHTMLClasses = remapKeys(o.rowHTMLClasses, rows)
+	if o.headerColumnOptions != nil {
+		headerColumnOptions := make(map[string]ColumnOptions, len(o.headerColumnOptions))
+		for k, v := range o.headerColumnOptions {
+			headerColumnOptions[k] = v
+		}
+		o.headerColumnOptions = headerColumnOptions
+	}
+	if o.cellStyles != nil {
+		cellStyles := make(map[cellIndex]Style)
+		for k, v := range o.cellStyles {
+			row, rowOk := rows[k.row]
+			col, colOk := cols[k.col]
+			if rowOk && colOk {
+				cellStyles[cellIndex{row, col}] = v
+			}
+		}
+		o.cellStyles = cellStyles
+	}
+	return o
+}
+
+func remapKeys[V any](m map[int]V, index map[int]int) map[int]V {
+	if m == nil {
+		return nil
+	}
+	r := make(map[int]V, len(m))
+	for k, v := range m {
+		if n, ok := index[k]; ok {
+			r[n] = v
+		}
+	}
+	return r
+}
@@ -0,0 +1,107 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	tab, _ := NewTableFromRows(
+		"Scores",
+		[]string{"id", "name", "score"},
+		[][]interface{}{{1, "Bob", 10}, {2, "Alice", 9.88}, {3, "Francis", 5.00002}},
+	)
+	tab.Options.SetCellFitContent(true)
+	tab.Options.SetRowStyle(2, Bold)
+
+	got := tab.Filter(func(row Row) bool {
+		score, _ := row.ValueByHeader("score")
+		f, _ := toFloat(score)
+		return f < 10 || row.Index() == 2
+	})
+	assert.Equal(t, [][]string{{"2", "3"}, {"Alice", "Francis"}, {"9.88", "5.00002"}}, got.columns)
+	assert.Equal(t, []int{2, 7, 7}, got.maxColLength)
+	assert.Equal(t, []int{5, 5, 7}, got.maxRowLength)
+	assert.Equal(t, Bold, got.Options.RowStyle(1))
+	assert.Equal(t, Style(""), got.Options.RowStyle(2))
+	assert.Equal(t, 3, tab.NumRows())
+
+	got = tab.Filter(func(row Row) bool { return row.String(1) == "Bob" })
+	assert.Equal(t, 1, got.NumRows())
+}
+
+func TestSelect(t *testing.T) {
+	t.Run("By name and index", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"Scores",
+			[]string{"id", "name", "score"},
+			[][]interface{}{{1, "Bob", 10}, {2, "Alice", 9.88}, {3, "Francis", 5.00002}},
+		)
+		tab.Options.SetCellFitContent(true)
+		var score ColumnOptions
+		score.SetCellAlign(RIGHT)
+		tab.Options.SetColumnOptions(2, score)
+
+		got, err := tab.Select("score", 1)
+		assert.NoError(t, err)
+
+		want := "         Scores          \n" +
+			"#===========#===========#\n" +
+			"#   score   #   name    #\n" +
+			"#===========#===========#\n" +
+			"|       10  |    Bob    |\n" +
+			"+-----------+-----------+\n" +
+			"|     9.88  |   Alice   |\n" +
+			"+-----------+-----------+\n" +
+			"|  5.00002  |  Francis  |\n" +
+			"+-----------+-----------+\n"
+		assert.Equal(t, want, got.Draw())
+		assert.Equal(t, []string{"score", "name"}, got.headers)
+		column := got.ColumnOptions(0)
+		assert.Equal(t, RIGHT, column.CellAlign())
+		assert.Equal(t, []int{7, 7}, got.maxColLength)
+	})
+
+	t.Run("Unknown columns", func(t *testing.T) {
+		tab, _ := NewTableFromRows(
+			"Scores",
+			[]string{"id", "name", "score"},
+			[][]interface{}{{1, "Bob", 10}, {2, "Alice", 9.88}, {3, "Francis", 5.00002}},
+		)
+		tab.Options.SetCellFitContent(true)
+		_, err := tab.Select("age")
+		if assert.Error(t, err) {
+			assert.Equal(t, "Unknown column: age", err.Error())
+		}
+		_, err = tab.Select(3)
+		assert.Error(t, err)
+		_, err = tab.Select(1.5)
+		assert.Error(t, err)
+		_, err = tab.Select()
+		assert.Error(t, err)
+	})
+}
+
+func TestHeadTail(t *testing.T) {
+	tab, _ := NewTableFromRows(
+		"Scores",
+		[]string{"id", "name", "score"},
+		[][]interface{}{{1, "Bob", 10}, {2, "Alice", 9.88}, {3, "Francis", 5.00002}},
+	)
+	tab.Options.SetCellFitContent(true)
+
+	head := tab.Head(2)
+	assert.Equal(t, [][]string{{"1", "2"}, {"Bob", "Alice"}, {"10", "9.88"}}, head.columns)
+	assert.Equal(t, []int{5, 5}, head.maxColLength[1:])
+
+	tail := tab.Tail(1)
+	assert.Equal(t, [][]string{{"3"}, {"Francis"}, {"5.00002"}}, tail.columns)
+
+	for _, tt := range []struct {
+		got  Table
+		want int
+	}{{tab.Head(10), 3}, {tab.Tail(10), 3}, {tab.Head(-1), 0}, {tab.Tail(-1), 0}} {
+		assert.Equal(t, tt.want, tt.got.NumRows())
+	}
+}
@@ -0,0 +1,212 @@
+package tymbol
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	zeroWidthJoiner = '\u200d'
+	emojiVariation  = '\ufe0f'
+
+	tabWidth = 8
+)
+
+// wideRanges lists code points rendered with two terminal columns
+// (East Asian Wide and Fullwidth plus emoji with default emoji presentation).
+var wideRanges = [][2]rune{
+	{0x1100, 0x115f}, {0x231a, 0x231b}, {0x2329, 0x232a}, {0x23e9, 0x23ec},
+	{0x23f0, 0x23f0}, {0x23f3, 0x23f3}, {0x25fd, 0x25fe}, {0x2614, 0x2615},
+	{0x2648, 0x2653}, {0x267f, 0x267f}, {0x2693, 0x2693}, {0x26a1, 0x26a1},
+	{0x26aa, 0x26ab}, {0x26bd, 0x26be}, {0x26c4, 0x26c5}, {0x26ce, 0x26ce},
+	{0x26d4, 0x26d4}, {0x26ea, 0x26ea}, {0x26f2, 0x26f3}, {0x26f5, 0x26f5},
+	{0x26fa, 0x26fa}, {0x26fd, 0x26fd}, {0x2705, 0x2705}, {0x270a, 0x270b},
+	{0x2728, 0x2728}, {0x274c, 0x274c}, {0x274e, 0x274e}, {0x2753, 0x2755},
+	{0x2757, 0x2757}, {0x2795, 0x2797}, {0x27b0, 0x27b0}, {0x27bf, 0x27bf},
+	{0x2b1b, 0x2b1c}, {0x2b50, 0x2b50}, {0x2b55, 0x2b55}, {0x2e80, 0x303e},
+	{0x3041, 0x33ff}, {0x3400, 0x4dbf}, {0x4e00, 0x9fff}, {0xa000, 0xa4cf},
+	{0xa960, 0xa97f}, {0xac00, 0xd7a3}, {0xf900, 0xfaff}, {0xfe10, 0xfe19},
+	{0xfe30, 0xfe6f}, {0xff00, 0xff60}, {0xffe0, 0xffe6}, {0x16fe0, 0x16fe4},
+	{0x17000, 0x18aff}, {0x1b000, 0x1b2ff}, {0x1f004, 0x1f004}, {0x1f0cf, 0x1f0cf},
+	{0x1f18e, 0x1f18e}, {0x1f191, 0x1f19a}, {0x1f1e6, 0x1f1ff}, {0x1f200, 0x1f251},
+	{0x1f300, 0x1f64f}, {0x1f680, 0x1f6ff}, {0x1f7e0, 0x1f7eb}, {0x1f90c, 0x1f9ff},
+	{0x1fa70, 0x1faff}, {0x20000, 0x2fffd}, {0x30000, 0x3fffd},
+}
+
+func isWide(r rune) bool {
+	i := sort.Search(len(wideRanges), func(i int) bool { return wideRanges[i][1] >= r })
+	return i < len(wideRanges) && wideRanges[i][0] <= r
+}
+
+func isZeroWidth(r rune) bool {
+	switch {
+	case r < 0x20, r >= 0x7f && r < 0xa0:
+		return true
+	case r >= 0x1160 && r <= 0x11ff:
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// isExtending reports whether r is attached to the preceding grapheme cluster.
+func isExtending(r rune) bool {
+	switch {
+	case r == zeroWidthJoiner:
+		return true
+	case r >= 0xfe00 && r <= 0xfe0f:
+		return true
+	case r >= 0x1f3fb && r <= 0x1f3ff:
+		return true
+	case r >= 0xe0020 && r <= 0xe007f:
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+func runeWidth(r rune) int {
+	if isZeroWidth(r) {
+		return 0
+	}
+	if isWide(r) {
+		return 2
+	}
+	return 1
+}
+
+// nextGrapheme splits off the first grapheme cluster of s. Clusters are
+// approximated as a base rune followed by combining marks, variation
+// selectors, emoji modifiers, zero width joiner sequences and regional
+// indicator pairs.
+func nextGrapheme(s string) (cluster, rest string) {
+	if s == "" {
+		return "", ""
+	}
+	first, size := utf8.DecodeRuneInString(s)
+	end := size
+	if first == '\r' && end < len(s) && s[end] == '\n' {
+		return s[:end+1], s[end+1:]
+	}
+	if isRegionalIndicator(first) {
+		if r, n := utf8.DecodeRuneInString(s[end:]); isRegionalIndicator(r) {
+			end += n
+		}
+	}
+	for end < len(s) {
+		r, n := utf8.DecodeRuneInString(s[end:])
+		if !isExtending(r) {
+			break
+		}
+		end += n
+		if r == zeroWidthJoiner && end < len(s) {
+			_, n = utf8.DecodeRuneInString(s[end:])
+			end += n
+		}
+	}
+	return s[:end], s[end:]
+}
+
+func graphemeWidth(cluster string) int {
+	first, _ := utf8.DecodeRuneInString(cluster)
+	w := runeWidth(first)
+	if w == 1 {
+		for _, r := range cluster {
+			if r == emojiVariation {
+				return 2
+			}
+		}
+	}
+	return w
+}
+
+// stringWidth returns the number of terminal columns needed to display s.
+// Escape sequences take no space.
+func stringWidth(s string) int {
+	var w int
+	for s != "" {
+		if n := escapeLength(s); n > 0 {
+			s = s[n:]
+			continue
+		}
+		var cluster string
+		cluster, s = nextGrapheme(s)
+		w += graphemeWidth(cluster)
+	}
+	return w
+}
+
+// expandTabs replaces tabs in a single line with spaces up to the next tab
+// stop, the way terminals display them.
+func expandTabs(s string) string {
+	if !strings.Contains(s, "\t") {
+		return s
+	}
+	var b strings.Builder
+	var w int
+	parts := strings.Split(s, "\t")
+	for i, part := range parts {
+		b.WriteString(part)
+		w += stringWidth(part)
+		if i < len(parts)-1 {
+			n := tabWidth - w%tabWidth
+			b.WriteString(strings.Repeat(SPACE, n))
+			w += n
+		}
+	}
+	return b.String()
+}
+
+// blockWidth returns the width of the widest line of s.
+func blockWidth(s string) int {
+	var w int
+	for _, line := range splitParagraphs(s) {
+		if lw := stringWidth(line); lw > w {
+			w = lw
+		}
+	}
+	return w
+}
+
+// cutWidth splits off the longest prefix of s that fits into width columns.
+// The prefix holds at least one grapheme cluster, even a too wide one, and
+// takes escape sequences that follow it.
+func cutWidth(s string, width int) (head, tail string) {
+	var pos, w int
+	var hasContent bool
+	for pos < len(s) {
+		if n := escapeLength(s[pos:]); n > 0 {
+			pos += n
+			continue
+		}
+		cluster, _ := nextGrapheme(s[pos:])
+		cw := graphemeWidth(cluster)
+		if w+cw > width && hasContent {
+			break
+		}
+		pos += len(cluster)
+		w += cw
+		hasContent = true
+	}
+	return s[:pos], s[pos:]
+}
+
+// splitByWidth breaks s into lines no wider than width columns without
+// cutting grapheme clusters. A cluster wider than width gets its own line.
+func splitByWidth(s string, width int) []string {
+	var lines []string
+	for s != "" {
+		line, rest := cutWidth(s, width)
+		if stringWidth(line) == 0 && len(lines) > 0 {
+			lines[len(lines)-1] += line
+		} else {
+			lines = append(lines, line)
+		}
+		s = rest
+	}
+	return balanceEscapes(lines)
+}
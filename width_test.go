@@ -0,0 +1,106 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringWidth(t *testing.T) {
+	cases := map[string]int{
+		"":         0,
+		"test":     4,
+		"Привет":   6,
+		"山田太郎":     8,
+		"e\u0301":  1,
+		"😀":        2,
+		"👍🏽":       2,
+		"👩\u200d💻": 2,
+		"🇯🇵":       2,
+		"❤\ufe0f":  2,
+	}
+	for s, want := range cases {
+		assert.Equal(t, want, stringWidth(s), s)
+	}
+}
+
+func TestSplitByWidth(t *testing.T) {
+	assert.Equal(t, []string(nil), splitByWidth("", 4))
+	assert.Equal(t, []string{"test", "test", "te"}, splitByWidth("testtestte", 4))
+	assert.Equal(t, []string{"Прив", "ет"}, splitByWidth("Привет", 4))
+	assert.Equal(t, []string{"山田", "太郎"}, splitByWidth("山田太郎", 5))
+	assert.Equal(t, []string{"e\u0301e\u0301", "e\u0301"}, splitByWidth("e\u0301e\u0301e\u0301", 2))
+	assert.Equal(t, []string{"👍🏽", "👍🏽"}, splitByWidth("👍🏽👍🏽", 3))
+}
+
+func TestExpandTabs(t *testing.T) {
+	assert.Equal(t, "test", expandTabs("test"))
+	assert.Equal(t, "        a", expandTabs("\ta"))
+	assert.Equal(t, "ab      c", expandTabs("ab\tc"))
+	assert.Equal(t, "山田    c", expandTabs("山田\tc"))
+	assert.Equal(t, []string{"a       b", "        c"}, splitParagraphs("a\tb\n\tc"))
+}
+
+func TestUnicodeTable(t *testing.T) {
+	t.Run("Fixed length", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1, 2}, {"Иван", "山田太郎"}},
+		)
+		tab.Options.SetCellLength(5)
+		assert.Equal(t, []int{2, 8}, tab.maxColLength)
+		assert.Equal(t, []int{4, 4, 8}, tab.maxRowLength)
+
+		got := tab.Draw()
+		want := `#=========#=========#
+#   id    #  name   #
+#=========#=========#
+|    1    |  Иван   |
++---------+---------+
+|    2    |  山田   |
+|         |  太郎   |
++---------+---------+
+`
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fit content", func(t *testing.T) {
+		tab, _ := NewTable(
+			"Имена",
+			[]string{"id", "name"},
+			[][]interface{}{{1, 2}, {"Иван", "山田太郎"}},
+		)
+		tab.Options.SetCellFitContent(true)
+
+		got := tab.Draw()
+		want := `        Имена        
+#======#============#
+#  id  #    name    #
+#======#============#
+|  1   |    Иван    |
++------+------------+
+|  2   |  山田太郎  |
++------+------------+
+`
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Tabs", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "name"},
+			[][]interface{}{{1}, {"a\tb"}},
+		)
+		tab.Options.SetCellFitContent(true)
+
+		got := tab.Draw()
+		want := `#======#=============#
+#  id  #    name     #
+#======#=============#
+|  1   |  a       b  |
++------+-------------+
+`
+		assert.Equal(t, want, got)
+	})
+}
@@ -0,0 +1,179 @@
+package tymbol
+
+import "strings"
+
+// splitParagraphs splits s on explicit line breaks and expands tabs.
+func splitParagraphs(s string) []string {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", NEW_LINE), NEW_LINE)
+	for i := range lines {
+		lines[i] = expandTabs(lines[i])
+	}
+	return lines
+}
+
+// wrapText breaks s into lines no wider than width columns. Explicit line
+// breaks are always kept, the rest depends on mode.
+func wrapText(s string, width int, mode string) []string {
+	if s == "" {
+		return nil
+	}
+
+	var lines []string
+	for _, p := range splitParagraphs(s) {
+		if stringWidth(p) == 0 {
+			lines = append(lines, p)
+			continue
+		}
+		switch mode {
+		case WRAP_WORD:
+			lines = append(lines, wrapWords(p, width)...)
+		default:
+			for p != "" {
+				var line string
+				line, p = cutWidth(p, width)
+				lines = append(lines, line)
+			}
+		}
+	}
+	return balanceEscapes(lines)
+}
+
+// wrapWords breaks a single paragraph on whitespace. Words longer than
+// width are split, with a hyphen if there is room for one.
+func wrapWords(p string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	var lineWidth int
+	var pending string
+	flush := func() {
+		lines = append(lines, line.String())
+		line.Reset()
+		lineWidth = 0
+	}
+
+	for _, word := range strings.FieldsFunc(p, func(r rune) bool { return r == ' ' || r == '\t' }) {
+		w := stringWidth(word)
+		if w == 0 {
+			pending += word
+			continue
+		}
+		word, pending = pending+word, ""
+
+		if w > width {
+			if lineWidth > 0 {
+				flush()
+			}
+			for stringWidth(word) > width {
+				head, rest := cutWidth(word, width-1)
+				// A wide cluster may leave no room for the hyphen.
+				if width > 1 && stringWidth(head) < width {
+					head += "-"
+				} else {
+					head, rest = cutWidth(word, width)
+				}
+				lines = append(lines, head)
+				word = rest
+			}
+			line.WriteString(word)
+			lineWidth = stringWidth(word)
+			continue
+		}
+
+		switch {
+		case lineWidth == 0:
+		case lineWidth+1+w <= width:
+			line.WriteString(SPACE)
+			lineWidth++
+		default:
+			flush()
+		}
+		line.WriteString(word)
+		lineWidth += w
+	}
+	line.WriteString(pending)
+	if line.Len() > 0 {
+		flush()
+	}
+	return lines
+}
+
+// balanceEscapes closes SGR sequences still active at the end of a line and
+// reopens them at the start of the next one, so styles never leak into
+// padding or borders.
+func balanceEscapes(lines []string) []string {
+	var active []string
+	for i, line := range lines {
+		prefix := strings.Join(active, "")
+		for s := line; s != ""; {
+			n := escapeLength(s)
+			if n == 0 {
+				_, s = nextGrapheme(s)
+				continue
+			}
+			seq := s[:n]
+			s = s[n:]
+			if isSGRReset(seq) {
+				active = active[:0]
+			} else if isSGR(seq) {
+				active = append(active, seq)
+			}
+		}
+		if len(active) > 0 {
+			line += sgrReset
+		}
+		lines[i] = prefix + line
+	}
+	return lines
+}
+
+// truncateText fits s into a single line of width columns, replacing the
+// cut part with ellipsis. OVERFLOW_TRUNCATE keeps the start of s,
+// OVERFLOW_TRUNCATE_MIDDLE keeps both the start and the end. Escape sequences
+// are never dropped, so styles stay balanced.
+func truncateText(s string, width int, mode string, ellipsis string) string {
+	s = strings.Join(splitParagraphs(s), SPACE)
+	total := stringWidth(s)
+	if total <= width {
+		return s
+	}
+
+	ellipsisWidth := stringWidth(ellipsis)
+	if ellipsisWidth > width {
+		ellipsis, ellipsisWidth = "", 0
+	}
+	headWidth, tailWidth := width-ellipsisWidth, 0
+	if mode == OVERFLOW_TRUNCATE_MIDDLE {
+		tailWidth = headWidth / 2
+		headWidth -= tailWidth
+	}
+
+	var b strings.Builder
+	var pos int
+	tailStart := total - tailWidth
+	inserted := false
+	for s != "" {
+		if n := escapeLength(s); n > 0 {
+			b.WriteString(s[:n])
+			s = s[n:]
+			continue
+		}
+		var cluster string
+		cluster, s = nextGrapheme(s)
+		w := graphemeWidth(cluster)
+		switch {
+		case pos+w <= headWidth:
+			b.WriteString(cluster)
+		case pos >= tailStart:
+			if !inserted {
+				b.WriteString(ellipsis)
+				inserted = true
+			}
+			b.WriteString(cluster)
+		case !inserted:
+			b.WriteString(ellipsis)
+			inserted = true
+		}
+		pos += w
+	}
+	return balanceEscapes([]string{b.String()})[0]
+}
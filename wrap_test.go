@@ -0,0 +1,151 @@
+package tymbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapText(t *testing.T) {
+	t.Run("Char wrap", func(t *testing.T) {
+		assert.Equal(t, []string(nil), wrapText("", 8, WRAP_CHAR))
+		assert.Equal(t, []string{"From fai", "rest cre", "atures"}, wrapText("From fairest creatures", 8, WRAP_CHAR))
+		assert.Equal(t, []string{"line one", "two"}, wrapText("line one\ntwo", 8, WRAP_CHAR))
+	})
+
+	t.Run("Word wrap", func(t *testing.T) {
+		assert.Equal(t, []string{"From", "fairest", "creatures"}, wrapText("From fairest creatures", 9, WRAP_WORD))
+		assert.Equal(t, []string{"a b c d", "e"}, wrapText("a  b c d e", 7, WRAP_WORD))
+		assert.Equal(t, []string{"superca-", "lifragi-", "listic", "is long"}, wrapText("supercalifragilistic is long", 8, WRAP_WORD))
+		assert.Equal(t, []string{"a", "b", "c"}, wrapText("abc", 1, WRAP_WORD))
+		assert.Equal(t, []string{"one", "", "two"}, wrapText("one\n\ntwo", 8, WRAP_WORD))
+		assert.Equal(t, []string{"a-", "b-", "日", "本", "語"}, wrapText("ab日本語", 2, WRAP_WORD))
+		assert.Equal(t, []string{"日-", "本語"}, wrapText("日本語", 4, WRAP_WORD))
+	})
+
+	t.Run("Styles are carried over line breaks", func(t *testing.T) {
+		got := wrapText("\x1b[31mred words\x1b[0m plain", 5, WRAP_WORD)
+		want := []string{"\x1b[31mred\x1b[0m", "\x1b[31mwords\x1b[0m", "plain"}
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestWordWrapTable(t *testing.T) {
+	tab, _ := NewTable(
+		"",
+		[]string{"id", "value"},
+		[][]interface{}{{1, 2}, {"From fairest creatures", "Look in\nthy glass"}},
+	)
+	tab.Options.SetCellLength(9)
+	tab.Options.SetCellAlign(LEFT)
+	err := tab.Options.SetWrapMode(WRAP_WORD)
+	assert.NoError(t, err)
+
+	got := tab.Draw()
+	want := `#=============#=============#
+#     id      #    value    #
+#=============#=============#
+|             |  From       |
+|  1          |  fairest    |
+|             |  creatures  |
++-------------+-------------+
+|  2          |  Look in    |
+|             |  thy glass  |
++-------------+-------------+
+`
+	assert.Equal(t, want, got)
+
+	err = tab.Options.SetWrapMode("hyphen")
+	if assert.Error(t, err) {
+		assert.Equal(t, "Unknown wrap mode. Expected [char word], got hyphen", err.Error())
+	}
+}
+
+func TestNewLinesFitContent(t *testing.T) {
+	tab, _ := NewTable(
+		"",
+		[]string{"id", "value"},
+		[][]interface{}{{1}, {"first line\nsecond"}},
+	)
+	tab.Options.SetCellFitContent(true)
+	assert.Equal(t, []int{2, 10}, tab.maxColLength)
+
+	got := tab.Draw()
+	want := `#======#==============#
+#  id  #    value     #
+#======#==============#
+|  1   |  first line  |
+|      |    second    |
++------+--------------+
+`
+	assert.Equal(t, want, got)
+}
+
+func TestTruncateText(t *testing.T) {
+	assert.Equal(t, "short", truncateText("short", 8, OVERFLOW_TRUNCATE, "…"))
+	assert.Equal(t, "From fa…", truncateText("From fairest creatures", 8, OVERFLOW_TRUNCATE, "…"))
+	assert.Equal(t, "From…res", truncateText("From fairest creatures", 8, OVERFLOW_TRUNCATE_MIDDLE, "…"))
+	assert.Equal(t, "/usr/l...in/go", truncateText("/usr/local/go/bin/go", 14, OVERFLOW_TRUNCATE_MIDDLE, "..."))
+	assert.Equal(t, "one two", truncateText("one\ntwo", 8, OVERFLOW_TRUNCATE, "…"))
+	assert.Equal(t, "山田…", truncateText("山田太郎", 6, OVERFLOW_TRUNCATE, "…"))
+	assert.Equal(t, "ab", truncateText("abc", 2, OVERFLOW_TRUNCATE, "..."))
+	assert.Equal(t, "\x1b[31mred…\x1b[0m", truncateText("\x1b[31mredred\x1b[0m", 4, OVERFLOW_TRUNCATE, "…"))
+}
+
+func TestTruncateTable(t *testing.T) {
+	t.Run("Fixed length", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "path"},
+			[][]interface{}{{1, 2}, {"/usr/local/bin/tymbol", "/tmp"}},
+		)
+		tab.Options.SetCellLength(8)
+		err := tab.Options.SetOverflow(OVERFLOW_TRUNCATE)
+		assert.NoError(t, err)
+
+		want := `#============#============#
+#     id     #    path    #
+#============#============#
+|     1      |  /usr/lo…  |
++------------+------------+
+|     2      |    /tmp    |
++------------+------------+
+`
+		assert.Equal(t, want, tab.Draw())
+
+		tab.Options.SetOverflow(OVERFLOW_TRUNCATE_MIDDLE)
+		tab.Options.SetEllipsis("~")
+		want = `#============#============#
+#     id     #    path    #
+#============#============#
+|     1      |  /usr~bol  |
++------------+------------+
+|     2      |    /tmp    |
++------------+------------+
+`
+		assert.Equal(t, want, tab.Draw())
+
+		err = tab.Options.SetOverflow("scroll")
+		if assert.Error(t, err) {
+			assert.Equal(t, "Unknown overflow mode. Expected [wrap truncate truncate-middle], got scroll", err.Error())
+		}
+	})
+
+	t.Run("Fit content", func(t *testing.T) {
+		tab, _ := NewTable(
+			"",
+			[]string{"id", "value"},
+			[][]interface{}{{1}, {"first line\nsecond"}},
+		)
+		tab.Options.SetCellFitContent(true)
+		tab.Options.SetOverflow(OVERFLOW_TRUNCATE)
+
+		want := `#======#==============#
+#  id  #    value     #
+#======#==============#
+|  1   |  first lin…  |
++------+--------------+
+`
+		assert.Equal(t, want, tab.Draw())
+	})
+}